@@ -138,7 +138,7 @@ func testFile(ctx context.Context, wasi extension.WASILoader, extObj suffiksv1.E
 	ctrl := controller.NewExtensionController(mgr)
 
 	for _, test := range t.Tests {
-		if !test.Test(ctx, ctrl, client) {
+		if !test.Test(ctx, ctrl, client, extObj.Name) {
 			return fmt.Errorf("test failed: %s", test.Name)
 		}
 	}
@@ -50,7 +50,8 @@ type test struct {
 	Delete     *deleteTest     `yaml:"delete"`
 }
 
-func (t test) Test(ctx context.Context, ctrl *controller.ExtensionController, client dynamic.Interface) bool {
+// Test runs the test against ctrl, which has the extension extName loaded.
+func (t test) Test(ctx context.Context, ctrl *controller.ExtensionController, client dynamic.Interface, extName string) bool {
 	if t.Validate != nil {
 		return t.validate(ctx, ctrl)
 	}
@@ -61,7 +62,7 @@ func (t test) Test(ctx context.Context, ctrl *controller.ExtensionController, cl
 		return t.sync(ctx, ctrl, client)
 	}
 	if t.Delete != nil {
-		return t.delete(ctx, ctrl, client)
+		return t.delete(ctx, ctrl, client, extName)
 	}
 
 	printLog(t.Name, "No test found")
@@ -97,7 +98,7 @@ func (t test) validate(ctx context.Context, ctrl *controller.ExtensionController
 		newO = nil
 	}
 
-	err := ctrl.Validate(ctx, typ, newO, old)
+	_, err := ctrl.Validate(ctx, typ, newO, old)
 	if err != nil {
 		if t.Validate.Invalid {
 			verboseLog(ctx, t.Name, "Expected error: %v", err)
@@ -207,11 +208,11 @@ func (t test) sync(ctx context.Context, ctrl *controller.ExtensionController, cl
 	return true
 }
 
-func (t test) delete(ctx context.Context, ctrl *controller.ExtensionController, client dynamic.Interface) bool {
+func (t test) delete(ctx context.Context, ctrl *controller.ExtensionController, client dynamic.Interface, extName string) bool {
 	verboseLog(ctx, t.Name, "Delete")
 
 	obj := newObject(t.Delete.Resource)
-	err := ctrl.Delete(ctx, obj)
+	err := ctrl.Delete(ctx, obj, []string{extName})
 	if err != nil {
 		printError(t.Name, "Unexpected error: %v", err)
 		return false
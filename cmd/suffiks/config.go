@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/yaml"
+)
+
+// loadConfig reads and validates the config file at path.
+// An empty config is returned when path is empty.
+func loadConfig(path string) (*suffiksv1.ProjectConfig, error) {
+	if path == "" {
+		return &suffiksv1.ProjectConfig{}, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("loadConfig: %w", err)
+	}
+
+	cfg := &suffiksv1.ProjectConfig{}
+	if err := yaml.UnmarshalStrict(b, cfg); err != nil {
+		return nil, fmt.Errorf("loadConfig: %s: %w", path, err)
+	}
+	if errs := cfg.Validate(); len(errs) > 0 {
+		return nil, fmt.Errorf("loadConfig: %s: %w", path, errs.ToAggregate())
+	}
+	return cfg, nil
+}
+
+// applyConfig overrides the manager options with the values set in cfg.
+func applyConfig(options *ctrl.Options, cfg *suffiksv1.ProjectConfig) {
+	if cfg.Health.HealthProbeBindAddress != "" {
+		options.HealthProbeBindAddress = cfg.Health.HealthProbeBindAddress
+	}
+	if cfg.Metrics.BindAddress != "" {
+		options.Metrics.BindAddress = cfg.Metrics.BindAddress
+	}
+	if cfg.Webhook.Port != 0 {
+		options.WebhookServer = webhook.NewServer(webhook.Options{Port: cfg.Webhook.Port})
+	}
+	if cfg.LeaderElection.LeaderElect != nil {
+		options.LeaderElection = *cfg.LeaderElection.LeaderElect
+	}
+	if cfg.LeaderElection.ResourceName != "" {
+		options.LeaderElectionID = cfg.LeaderElection.ResourceName
+	}
+	if cfg.LeaderElection.ResourceNamespace != "" {
+		options.LeaderElectionNamespace = cfg.LeaderElection.ResourceNamespace
+	}
+}
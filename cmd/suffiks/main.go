@@ -14,6 +14,7 @@ import (
 	"github.com/suffiks/suffiks/internal/controller"
 	"github.com/suffiks/suffiks/internal/docparser"
 	"github.com/suffiks/suffiks/internal/extension"
+	"github.com/suffiks/suffiks/internal/extension/oci"
 	"github.com/suffiks/suffiks/internal/tracing"
 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -23,12 +24,16 @@ import (
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/dynamic"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -53,8 +58,11 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var configFile string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&configFile, "config", "", "The operator will load its configuration from this file. "+
+		"Values set in the file take precedence over command line flags.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -86,9 +94,12 @@ func main() {
 
 	setupLog := ctrl.Log.WithName("setup")
 
-	var err error
-	// TODO: Re-introduce config file support
-	// ctrlConfig := suffiksv1.ProjectConfig{}
+	ctrlConfig, err := loadConfig(configFile)
+	if err != nil {
+		setupLog.Error(err, "unable to load the config file")
+		os.Exit(1)
+	}
+
 	options := ctrl.Options{
 		Scheme: scheme,
 		Metrics: server.Options{
@@ -99,13 +110,16 @@ func main() {
 		LeaderElectionID:       "operator.suffiks.com",
 		NewCache:               cache.New,
 	}
-	// if configFile != "" {
-	// 	options, err = options.AndFrom(ctrl.ConfigFile().AtPath(configFile).OfKind(&ctrlConfig))
-	// 	if err != nil {
-	// 		setupLog.Error(err, "unable to load the config file")
-	// 		os.Exit(1)
-	// 	}
-	// }
+	applyConfig(&options, ctrlConfig)
+
+	ociClient, err := oci.New(oci.Options{
+		PlainHTTPRegistries: ctrlConfig.OCI.PlainHTTPRegistries,
+		CredentialsFile:     ctrlConfig.OCI.CredentialsFile,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to create OCI client")
+		os.Exit(1)
+	}
 
 	cfg := ctrl.GetConfigOrDie()
 	cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
@@ -131,13 +145,42 @@ func main() {
 		os.Exit(1)
 	}
 
-	crdMgr, err := extension.NewExtensionManager(ctx, suffiks.CRDFiles, dynClient, extension.WithGRPCOptions(grpcOptions...))
+	healthEvents := make(chan event.GenericEvent, 16)
+	healthNotifier := func(name string, healthy bool) {
+		setupLog.Info("extension health changed", "extension", name, "healthy", healthy)
+		// Never block the health monitor, as the events aren't consumed until
+		// the Extension controller is started, or at all when not the leader.
+		select {
+		case healthEvents <- event.GenericEvent{Object: &suffiksv1.Extension{ObjectMeta: metav1.ObjectMeta{Name: name}}}:
+		default:
+			setupLog.Info("dropped extension health event, queue is full", "extension", name)
+		}
+	}
+
+	mgrOpts := []extension.Option{
+		extension.WithGRPCOptions(grpcOptions...),
+		extension.WithHealthNotifier(healthNotifier),
+		extension.WithWASILoader(ociClient.Get),
+		extension.WithWASIConfig(ctrlConfig.WASI),
+		extension.WithNamespaceLabels(namespaceLabels(mgr.GetClient())),
+	}
+	if ctrlConfig.OCI.Timeout != nil {
+		mgrOpts = append(mgrOpts, extension.WithWASIFetchTimeout(ctrlConfig.OCI.Timeout.Duration))
+	}
+
+	crdMgr, err := extension.NewExtensionManager(
+		ctx,
+		suffiks.CRDFiles,
+		dynClient,
+		mgrOpts...,
+	)
 	if err != nil {
 		setupLog.Error(err, "unable to create CRD manager")
 		os.Exit(1)
 	}
 
 	extController := controller.NewExtensionController(crdMgr)
+	extController.SetEventRecorder(mgr)
 
 	if err := extController.RegisterMetrics(metrics.Registry); err != nil {
 		setupLog.Error(err, "unable to register CRD metrics")
@@ -145,10 +188,11 @@ func main() {
 	}
 
 	extRec := &controller.ExtensionReconciler{
-		Client:     mgr.GetClient(),
-		Scheme:     mgr.GetScheme(),
-		KubeConfig: cfg,
-		CRDManager: crdMgr,
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		KubeConfig:   cfg,
+		CRDManager:   crdMgr,
+		HealthEvents: healthEvents,
 	}
 	if err = (extRec).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Extension")
@@ -158,9 +202,9 @@ func main() {
 	appRec := controller.New(
 		mgr.GetClient(),
 		&controller.AppReconciler{
-			Scheme: mgr.GetScheme(),
-			Client: mgr.GetClient(),
-			// Defaults: ctrlConfig.ApplicationDefaults,
+			Scheme:   mgr.GetScheme(),
+			Client:   mgr.GetClient(),
+			Defaults: ctrlConfig.ApplicationDefaults,
 		},
 		extController,
 	)
@@ -169,20 +213,20 @@ func main() {
 		os.Exit(1)
 	}
 
-	// workRec := &controllers.ReconcilerWrapper[*base.Work]{
-	// 	Client: mgr.GetClient(),
-	// 	Child: &controllers.JobReconciler{
-	// 		Scheme: mgr.GetScheme(),
-	// 		Client: mgr.GetClient(),
-	// 	},
-	// 	CRDController: extController,
-	// }
-	// if err = workRec.SetupWithManager(mgr); err != nil {
-	// 	setupLog.Error(err, "unable to create controller", "controller", "Application")
-	// 	os.Exit(1)
-	// }
-
-	if true { //!ctrlConfig.WebhooksDisabled {
+	workRec := controller.New(
+		mgr.GetClient(),
+		&controller.JobReconciler{
+			Scheme: mgr.GetScheme(),
+			Client: mgr.GetClient(),
+		},
+		extController,
+	)
+	if err = workRec.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Work")
+		os.Exit(1)
+	}
+
+	if !ctrlConfig.WebhooksDisabled {
 		if err = (&suffiksv1.Extension{}).SetupWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Extension")
 			os.Exit(1)
@@ -193,10 +237,10 @@ func main() {
 			os.Exit(1)
 		}
 
-		// if err := workRec.SetupWebhookWithManager(mgr); err != nil {
-		// 	setupLog.Error(err, "unable to create webhook", "webhook", "Work")
-		// 	os.Exit(1)
-		// }
+		if err := workRec.SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Work")
+			os.Exit(1)
+		}
 	}
 
 	//+kubebuilder:scaffold:builder
@@ -214,13 +258,15 @@ func main() {
 	}
 
 	tracerLog := ctrl.Log.WithName("tracing")
-	err = tracing.Provider(ctx, tracerLog) // ctrlConfig.Tracing)
+	err = tracing.Provider(ctx, tracerLog, ctrlConfig.Tracing)
 	if err != nil {
 		setupLog.Error(err, "unable to create tracer provider")
 		os.Exit(1)
 	}
 
-	go documentationServer(ctx, "" /*ctrlConfig.DocumentationAddress*/, crdMgr, setupLog)
+	if ctrlConfig.DocumentationAddress != "0" {
+		go documentationServer(ctx, ctrlConfig.DocumentationAddress, crdMgr, setupLog)
+	}
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
@@ -285,3 +331,15 @@ func updateDocs(ctx context.Context, ctrl *docparser.Controller, mgr *extension.
 		}
 	}
 }
+
+// namespaceLabels returns the labels of namespaces using only their cached metadata.
+func namespaceLabels(c client.Reader) extension.NamespaceLabels {
+	return func(ctx context.Context, name string) (map[string]string, error) {
+		ns := &metav1.PartialObjectMetadata{}
+		ns.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Namespace"))
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+			return nil, err
+		}
+		return ns.GetLabels(), nil
+	}
+}
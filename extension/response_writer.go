@@ -56,3 +56,59 @@ func (r *ResponseWriter) MergePatch(b []byte) error {
 		},
 	})
 }
+
+// SetStatus reports the status of the extension for the object it runs for.
+// The status is stored in the status of the object, under the name of the
+// extension, and is cleared when the extension no longer runs for the object.
+// Calling SetStatus multiple times replaces the previous status.
+func (r *ResponseWriter) SetStatus(phase, message string, outputs map[string]string) error {
+	return r.w.Send(&protogen.Response{
+		OFResponse: &protogen.Response_Status{
+			Status: &protogen.ExtensionStatus{
+				Phase:   phase,
+				Message: message,
+				Outputs: outputs,
+			},
+		},
+	})
+}
+
+// AddVolume adds a volume to the pod of the workload. Exactly one source of
+// the volume must be set. Adding a different volume with the name of an
+// existing volume fails the sync.
+func (r *ResponseWriter) AddVolume(volume *protogen.Volume) error {
+	return r.w.Send(&protogen.Response{
+		OFResponse: &protogen.Response_Volume{
+			Volume: volume,
+		},
+	})
+}
+
+// AddVolumeMount mounts a volume in the main container of the workload.
+// The volume must be part of the pod, either from the workload or added using AddVolume.
+func (r *ResponseWriter) AddVolumeMount(name, mountPath string, readOnly bool) error {
+	return r.w.Send(&protogen.Response{
+		OFResponse: &protogen.Response_VolumeMount{
+			VolumeMount: &protogen.VolumeMount{
+				Name:      name,
+				MountPath: mountPath,
+				ReadOnly:  readOnly,
+			},
+		},
+	})
+}
+
+// RecordEvent records a Kubernetes Event on the object the extension runs for,
+// shown by `kubectl describe`. Reason is a short CamelCase reason, like HostTaken.
+// Events are rate limited per extension and object, and dropped when exceeding the limit.
+func (r *ResponseWriter) RecordEvent(typ protogen.EventType, reason, message string) error {
+	return r.w.Send(&protogen.Response{
+		OFResponse: &protogen.Response_Event{
+			Event: &protogen.Event{
+				Type:    typ,
+				Reason:  reason,
+				Message: message,
+			},
+		},
+	})
+}
@@ -13,9 +13,12 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/suffiks/suffiks/extension/protogen"
 	"github.com/suffiks/suffiks/internal/tracing"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type server[T any] struct {
@@ -47,7 +50,8 @@ func Serve[T any](ctx context.Context, config Config, ext Extension[T], doc *Doc
 			grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		)
 
-		if err := tracing.Provider(ctx, logr.Discard() /*, config.getTracing()*/); err != nil {
+		tc := config.getTracing()
+		if err := tracing.Provider(ctx, logr.Discard(), suffiksv1.TracingConfig{OTLPEndpoint: tc.OTLPEndpoint, Attributes: tc.Attributes}); err != nil {
 			return err
 		}
 	}
@@ -74,6 +78,7 @@ func Serve[T any](ctx context.Context, config Config, ext Extension[T], doc *Doc
 	}
 
 	protogen.RegisterExtensionServer(s, NewServer(ext, pages))
+	healthpb.RegisterHealthServer(s, health.NewServer())
 
 	g, ctx := errgroup.WithContext(ctx)
 	g.Go(func() error { return s.Serve(lis) })
@@ -133,6 +138,7 @@ func (s *server[T]) Default(ctx context.Context, req *protogen.SyncRequest) (*pr
 		}
 	}
 
+	ctx, warnings := withWarnings(ctx)
 	def, err := s.dext.Default(ctx, Owner{owner: req.GetOwner()}, obj)
 	if err != nil {
 		log.Println("defaulting error:", err)
@@ -146,7 +152,8 @@ func (s *server[T]) Default(ctx context.Context, req *protogen.SyncRequest) (*pr
 	}
 
 	resp := &protogen.DefaultResponse{
-		Spec: spec,
+		Spec:     spec,
+		Warnings: warnings.list,
 	}
 
 	return resp, nil
@@ -174,13 +181,14 @@ func (s *server[T]) Validate(ctx context.Context, req *protogen.ValidationReques
 		}
 	}
 
+	ctx, warnings := withWarnings(ctx)
 	valErrs, err := s.vext.Validate(ctx, ValidationType(req.Type), Owner{owner: sync.GetOwner()}, newObject, oldObject)
 	if err != nil {
 		log.Println("validation error:", err)
 		return nil, err
 	}
 
-	resp := &protogen.ValidationResponse{}
+	resp := &protogen.ValidationResponse{Warnings: warnings.list}
 	for _, valErr := range valErrs {
 		resp.Errors = append(resp.Errors, &protogen.ValidationError{
 			Path:   valErr.Path,
@@ -66,11 +66,13 @@ func (i *IntegrationTester[Ext]) Run(t *testing.T, tests ...TestCase) {
 func (i *IntegrationTester[Ext]) runTest(t *testing.T, client *fake.Clientset, tr *Suffiks[Ext], test TestCase) {
 	t.Helper()
 
-	test.runTest(t, tr.ctrl, client)
+	test.runTest(t, tr.ctrl, client, []string{tr.name})
 }
 
 type TestCase interface {
-	runTest(t *testing.T, ctrl *controller.ExtensionController, client *fake.Clientset)
+	// runTest runs the test, where extensions are the extensions applied
+	// to the objects of the test.
+	runTest(t *testing.T, ctrl *controller.ExtensionController, client *fake.Clientset, extensions []string)
 	name() string
 	existing() []runtime.Object
 }
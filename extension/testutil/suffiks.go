@@ -19,6 +19,7 @@ import (
 
 type Suffiks[Ext any] struct {
 	extension extension.Extension[Ext]
+	name      string
 
 	ctrl       *controller.ExtensionController
 	listener   *bufconn.Listener
@@ -46,6 +47,7 @@ func New[Ext any](ext extension.Extension[Ext], spec io.Reader) (*Suffiks[Ext],
 	if err := extMgr.Add(extObj); err != nil {
 		return nil, err
 	}
+	t.name = extObj.Name
 	t.ctrl = controller.NewExtensionController(extMgr)
 	return t, nil
 }
@@ -26,8 +26,8 @@ type DeleteTest struct {
 
 func (s DeleteTest) name() string               { return s.Name }
 func (s DeleteTest) existing() []runtime.Object { return s.Existing }
-func (s DeleteTest) runTest(t *testing.T, ctrl *controller.ExtensionController, client *fake.Clientset) {
-	if err := ctrl.Delete(context.Background(), fixObject(t, s.Object)); err != nil {
+func (s DeleteTest) runTest(t *testing.T, ctrl *controller.ExtensionController, client *fake.Clientset, extensions []string) {
+	if err := ctrl.Delete(context.Background(), fixObject(t, s.Object), extensions); err != nil {
 		if s.ErrCheck == nil {
 			t.Fatal(err)
 		} else {
@@ -25,7 +25,7 @@ type SyncTest struct {
 
 func (s SyncTest) name() string               { return s.Name }
 func (s SyncTest) existing() []runtime.Object { return s.Existing }
-func (s SyncTest) runTest(t *testing.T, ctrl *controller.ExtensionController, client *fake.Clientset) {
+func (s SyncTest) runTest(t *testing.T, ctrl *controller.ExtensionController, client *fake.Clientset, _ []string) {
 	t.Helper()
 
 	cs, err := ctrl.Sync(context.Background(), fixObject(t, s.Object))
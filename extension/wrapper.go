@@ -2,6 +2,7 @@ package extension
 
 import (
 	"context"
+	"sync"
 
 	"github.com/suffiks/suffiks/extension/protogen"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -73,10 +74,42 @@ type Extension[Object any] interface {
 	Delete(ctx context.Context, owner Owner, obj Object) (protogen.DeleteResponse, error)
 }
 
+// ValidatableExtension is implemented by extensions validating objects.
+// Warnings about objects which are still accepted, such as the use of
+// deprecated fields, are added using AddWarning.
 type ValidatableExtension[Object any] interface {
 	Validate(ctx context.Context, typ ValidationType, owner Owner, newObject, oldObject Object) ([]ValidationErrors, error)
 }
 
+// DefaultableExtension is implemented by extensions setting default values of objects.
+// Warnings are added using AddWarning.
 type DefaultableExtension[Object any] interface {
 	Default(ctx context.Context, owner Owner, obj Object) (Object, error)
 }
+
+type warningsKey struct{}
+
+type warnings struct {
+	lock sync.Mutex
+	list []string
+}
+
+// withWarnings returns a context collecting the warnings added using AddWarning.
+func withWarnings(ctx context.Context) (context.Context, *warnings) {
+	w := &warnings{}
+	return context.WithValue(ctx, warningsKey{}, w), w
+}
+
+// AddWarning adds a warning shown to the user, for example by kubectl,
+// without rejecting the request. It is only valid in the context passed to
+// Validate and Default, and is ignored elsewhere.
+func AddWarning(ctx context.Context, warning string) {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+	if !ok {
+		return
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.list = append(w.list, warning)
+}
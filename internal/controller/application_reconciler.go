@@ -9,11 +9,15 @@ import (
 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"go.opentelemetry.io/otel/attribute"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"k8s.io/utils/strings/slices"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,8 +25,10 @@ import (
 )
 
 var (
-	_ Reconciler[*suffiksv1.Application]        = &AppReconciler{}
-	_ ReconcilerDefault[*suffiksv1.Application] = &AppReconciler{}
+	_ Reconciler[*suffiksv1.Application]         = &AppReconciler{}
+	_ ReconcilerDefault[*suffiksv1.Application]  = &AppReconciler{}
+	_ ReconcilerValidate[*suffiksv1.Application] = &AppReconciler{}
+	_ ReconcilerWorkload[*suffiksv1.Application] = &AppReconciler{}
 )
 
 // When changing the lines below, run make
@@ -31,6 +37,7 @@ var (
 //+kubebuilder:rbac:groups=suffiks.com,resources=applications/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // ValidationWebhook
 //+kubebuilder:webhook:path=/validate-suffiks-com-v1-application,mutating=false,failurePolicy=fail,sideEffects=None,groups=suffiks.com,resources=applications,verbs=create;update;delete,versions=v1,name=vapplication.kb.io,admissionReviewVersions=v1
 // DefaultingWebhook
@@ -40,7 +47,8 @@ type AppReconciler struct {
 	Scheme *runtime.Scheme
 	Client client.Client
 
-	// Defaults *suffiksv1.ApplicationDefaults
+	// Defaults are applied to Applications by the defaulting webhook.
+	Defaults *suffiksv1.ApplicationDefaults
 }
 
 func (a *AppReconciler) NewObject() *suffiksv1.Application { return &suffiksv1.Application{} }
@@ -54,7 +62,11 @@ func (a *AppReconciler) CreateOrUpdate(ctx context.Context, app *suffiksv1.Appli
 		return err
 	}
 
-	depl := a.newDeployment(app, spec)
+	depl, err := a.newDeployment(app, spec)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
 	if err := controllerutil.SetControllerReference(app, depl, a.Scheme); err != nil {
 		span.RecordError(err)
 		return fmt.Errorf("unable to set controller reference: %w", err)
@@ -67,76 +79,140 @@ func (a *AppReconciler) CreateOrUpdate(ctx context.Context, app *suffiksv1.Appli
 
 	depl.Spec.Template.Annotations = mergeMaps(depl.Spec.Template.Annotations, depl.Annotations)
 
-	if spec.Port > 0 {
-		svc := &corev1.Service{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      app.Name,
-				Namespace: app.Namespace,
-			},
+	hash, err := app.Hash()
+	if err != nil {
+		return fmt.Errorf("error hashing application: %w", err)
+	}
+	// Set after copying the annotations to the pod template, to not roll out
+	// the Deployment on changes only affecting other resources.
+	depl.Annotations = mergeMaps(depl.Annotations, map[string]string{hashAnnotation: hash})
+
+	if svc := a.newService(app, spec); svc != nil {
+		svc.Labels = mergeMaps(depl.Labels)
+		svc.Annotations = mergeMaps(svc.Annotations, map[string]string{hashAnnotation: hash})
+		if err := controllerutil.SetControllerReference(app, svc, a.Scheme); err != nil {
+			span.RecordError(err)
+			return fmt.Errorf("unable to set controller reference: %w", err)
 		}
-		if err := a.Client.Get(ctx, client.ObjectKeyFromObject(svc), svc); err != nil && !errors.IsNotFound(err) {
+
+		span.SetAttributes(attribute.String("action", "apply svc"))
+		if err := serverSideApply(ctx, a.Client, a.Scheme, svc); err != nil {
 			span.RecordError(err)
-			return fmt.Errorf("error getting service: %w", err)
+			return fmt.Errorf("Reconcile apply svc: %w", err)
 		}
+	} else if err := a.deleteService(ctx, app); err != nil {
+		span.RecordError(err)
+		return err
+	}
 
-		update := err == nil || !errors.IsNotFound(err)
-		svc.Spec = corev1.ServiceSpec{
-			Ports: []corev1.ServicePort{
-				{
-					Name:       "http",
-					Port:       80,
-					TargetPort: intstr.FromInt(spec.Port),
-				},
-			},
-			Selector: map[string]string{
-				"app.kubernetes.io/name": app.Name,
-			},
+	// The HPA is applied before the Deployment, so it scales the Deployment as
+	// soon as the Deployment stops setting its replicas.
+	if err := a.reconcileHPA(ctx, app, spec, hash); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	span.SetAttributes(attribute.String("action", "apply deployment"))
+	if err := serverSideApply(ctx, a.Client, a.Scheme, depl); err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("Reconcile apply deployment: %w", err)
+	}
+	return nil
+}
+
+// deleteService deletes the Service of app, if it's controlled by app.
+// A Service with the same name created by someone else is kept.
+func (a *AppReconciler) deleteService(ctx context.Context, app *suffiksv1.Application) error {
+	svc := &corev1.Service{}
+	if err := a.Client.Get(ctx, client.ObjectKeyFromObject(app), svc); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Reconcile get svc: %w", err)
+	}
+	if !metav1.IsControlledBy(svc, app) {
+		return nil
+	}
+
+	tracing.Get(ctx).SetAttributes(attribute.String("action", "delete svc"))
+	if err := a.Client.Delete(ctx, svc); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("Reconcile delete svc: %w", err)
+	}
+	return nil
+}
+
+// reconcileHPA applies the HPA of app when autoscaling is enabled, and deletes
+// it otherwise. When the HPA is created for an existing Deployment, its current
+// replicas are handed over, see handoverReplicas.
+func (a *AppReconciler) reconcileHPA(ctx context.Context, app *suffiksv1.Application, spec suffiksv1.ApplicationSpec, hash string) error {
+	span := tracing.Get(ctx)
+
+	existing := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := a.Client.Get(ctx, client.ObjectKeyFromObject(app), existing)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("Reconcile get hpa: %w", err)
+	}
+	exists := err == nil
+
+	if spec.Autoscaling == nil {
+		if !exists {
+			return nil
 		}
-		if svc.Labels == nil {
-			svc.Labels = map[string]string{}
+
+		span.SetAttributes(attribute.String("action", "delete hpa"))
+		if err := a.Client.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("Reconcile delete hpa: %w", err)
 		}
-		for k, v := range depl.Labels {
-			svc.Labels[k] = v
+		if err := a.handoverReplicas(ctx, app, false); err != nil {
+			return fmt.Errorf("Reconcile release replicas: %w", err)
 		}
+		return nil
+	}
 
-		if update {
-			span.SetAttributes(attribute.String("action", "update svc"))
-			if err := a.Client.Update(ctx, svc); err != nil {
-				span.RecordError(err)
-				return fmt.Errorf("Reconcile update svc: %w", err)
-			}
-		} else {
-			span.SetAttributes(attribute.String("action", "create svc"))
-			if err := a.Client.Create(ctx, svc); err != nil {
-				span.RecordError(err)
-				return fmt.Errorf("Reconcile create svc: %w", err)
-			}
+	if !exists {
+		if err := a.handoverReplicas(ctx, app, true); err != nil {
+			return fmt.Errorf("Reconcile handover replicas: %w", err)
 		}
 	}
 
-	existingDepl := &appsv1.Deployment{}
-	if err := a.Client.Get(ctx, client.ObjectKeyFromObject(depl), existingDepl); err != nil && !errors.IsNotFound(err) {
-		span.RecordError(err)
-		return fmt.Errorf("error getting deployment: %w", err)
+	hpa := a.newHPA(app, spec.Autoscaling)
+	hpa.Annotations = map[string]string{hashAnnotation: hash}
+	if err := controllerutil.SetControllerReference(app, hpa, a.Scheme); err != nil {
+		return fmt.Errorf("unable to set controller reference: %w", err)
 	}
 
-	if err == nil && existingDepl.Generation > 0 {
-		depl.Generation = existingDepl.Generation
-		span.SetAttributes(attribute.String("action", "update deployment"))
-		if err := a.Client.Update(ctx, depl); err != nil {
-			span.RecordError(err)
-			return fmt.Errorf("Reconcile update deployment: %w", err)
-		}
-	} else {
-		if err := a.Client.Create(ctx, depl); err != nil {
-			span.RecordError(err)
-			return fmt.Errorf("Reconcile create deployment: %w", err)
-		}
+	span.SetAttributes(attribute.String("action", "apply hpa"))
+	if err := serverSideApply(ctx, a.Client, a.Scheme, hpa); err != nil {
+		return fmt.Errorf("Reconcile apply hpa: %w", err)
 	}
 	return nil
 }
 
-func (a *AppReconciler) UpdateStatus(ctx context.Context, app *suffiksv1.Application, extensions []string) (updates bool, err error) {
+// handoverReplicas applies the current replicas of the Deployment of app using
+// handoverFieldManager, or releases them again when keep is false.
+//
+// The Deployment doesn't set its replicas when autoscaling is enabled. Without
+// another owner, server-side apply would remove the field, resetting the
+// Deployment to a single replica until the HPA scales it up again.
+func (a *AppReconciler) handoverReplicas(ctx context.Context, app *suffiksv1.Application, keep bool) error {
+	depl := &appsv1.Deployment{}
+	if err := a.Client.Get(ctx, client.ObjectKeyFromObject(app), depl); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+	u.SetName(depl.Name)
+	u.SetNamespace(depl.Namespace)
+	if keep && depl.Spec.Replicas != nil {
+		if err := unstructured.SetNestedField(u.Object, int64(*depl.Spec.Replicas), "spec", "replicas"); err != nil {
+			return err
+		}
+	}
+	return a.Client.Patch(ctx, u, client.Apply, client.FieldOwner(handoverFieldManager))
+}
+
+func (a *AppReconciler) UpdateStatus(ctx context.Context, app *suffiksv1.Application, extensions []string, reported map[string]suffiksv1.OwnerExtensionStatus) (updates bool, err error) {
 	hash, err := app.Hash()
 	if err != nil {
 		return updates, fmt.Errorf("error hashing application: %w", err)
@@ -151,6 +227,12 @@ func (a *AppReconciler) UpdateStatus(ctx context.Context, app *suffiksv1.Applica
 		app.Status.Extensions = extensions
 	}
 
+	statuses := extensionStatuses(app.Status.ExtensionStatuses, reported, extensions)
+	if !equality.Semantic.DeepEqual(app.Status.ExtensionStatuses, statuses) {
+		updates = true
+		app.Status.ExtensionStatuses = statuses
+	}
+
 	depl := &appsv1.Deployment{}
 	if err := a.Client.Get(ctx, client.ObjectKeyFromObject(app), depl); err != nil {
 		return updates, nil
@@ -169,6 +251,25 @@ func (a *AppReconciler) UpdateStatus(ctx context.Context, app *suffiksv1.Applica
 	return updates, nil
 }
 
+// WorkloadStatus reports the Deployment of the application as ready when all
+// desired replicas are available, and as progressing while it's rolling out.
+func (a *AppReconciler) WorkloadStatus(ctx context.Context, app *suffiksv1.Application) (WorkloadStatus, error) {
+	depl := &appsv1.Deployment{}
+	if err := a.Client.Get(ctx, client.ObjectKeyFromObject(app), depl); err != nil {
+		if errors.IsNotFound(err) {
+			return WorkloadStatus{Progressing: true, Message: "Deployment is not created yet"}, nil
+		}
+		return WorkloadStatus{}, fmt.Errorf("WorkloadStatus: get deployment: %w", err)
+	}
+
+	desired := ptr.Deref(depl.Spec.Replicas, 1)
+	return WorkloadStatus{
+		Ready:       depl.Status.AvailableReplicas >= desired,
+		Progressing: depl.Status.ObservedGeneration < depl.Generation || depl.Status.UpdatedReplicas < desired,
+		Message:     fmt.Sprintf("%d/%d replicas available", depl.Status.AvailableReplicas, desired),
+	}, nil
+}
+
 func (a *AppReconciler) IsModified(ctx context.Context, app *suffiksv1.Application) (bool, error) {
 	h, err := app.Hash()
 	if err != nil {
@@ -182,13 +283,19 @@ func (a *AppReconciler) IsModified(ctx context.Context, app *suffiksv1.Applicati
 	}
 
 	ok := client.ObjectKeyFromObject(app)
-	if err := a.Client.Get(ctx, ok, &appsv1.Deployment{}); err != nil && errors.IsNotFound(err) {
+	depl := &appsv1.Deployment{}
+	if err := a.Client.Get(ctx, ok, depl); err != nil && errors.IsNotFound(err) {
 		return true, nil
 	} else if err != nil {
 		return false, err
 	}
 	tracing.Get(ctx).AddEvent("Got from client")
 
+	if depl.Annotations[hashAnnotation] != h {
+		tracing.Get(ctx).AddEvent("Deployment hash mismatch")
+		return true, nil
+	}
+
 	spec, err := app.WellKnownSpec()
 	if err != nil {
 		tracing.Get(ctx).RecordError(fmt.Errorf("IsModified: get well known spec: %w", err))
@@ -196,7 +303,7 @@ func (a *AppReconciler) IsModified(ctx context.Context, app *suffiksv1.Applicati
 	}
 	tracing.Get(ctx).AddEvent("Got well known spec")
 
-	if spec.Port > 0 {
+	if len(exposedPorts(spec)) > 0 {
 		tracing.Get(ctx).AddEvent("Checking service")
 		if err := a.Client.Get(ctx, ok, &corev1.Service{}); err != nil && errors.IsNotFound(err) {
 			return true, nil
@@ -206,6 +313,15 @@ func (a *AppReconciler) IsModified(ctx context.Context, app *suffiksv1.Applicati
 		}
 		tracing.Get(ctx).AddEvent("Done checking service")
 	}
+
+	if spec.Autoscaling != nil {
+		if err := a.Client.Get(ctx, ok, &autoscalingv2.HorizontalPodAutoscaler{}); err != nil && errors.IsNotFound(err) {
+			return true, nil
+		} else if err != nil {
+			tracing.Get(ctx).RecordError(fmt.Errorf("IsModified: get hpa: %w", err))
+			return false, err
+		}
+	}
 	return false, nil
 }
 
@@ -214,7 +330,10 @@ func (a *AppReconciler) Delete(ctx context.Context, app *suffiksv1.Application)
 	if err != nil && !errors.IsNotFound(err) {
 		return err
 	}
-	err = a.Client.Delete(ctx, &corev1.Service{ObjectMeta: a.objectMeta(app)})
+	if err := a.deleteService(ctx, app); err != nil {
+		return err
+	}
+	err = a.Client.Delete(ctx, &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: a.objectMeta(app)})
 	if err != nil && !errors.IsNotFound(err) {
 		return err
 	}
@@ -227,51 +346,80 @@ func (a *AppReconciler) Extensions(app *suffiksv1.Application) []string {
 
 func (a *AppReconciler) Owns() []client.Object {
 	return []client.Object{
-		// TODO: Fix recursive reconciliation loop before enabling this
-		// &appsv1.Deployment{},
-		// &corev1.Service{},
+		&appsv1.Deployment{},
+		&corev1.Service{},
+		&autoscalingv2.HorizontalPodAutoscaler{},
 	}
 }
 
 func (a *AppReconciler) Default(ctx context.Context, obj *suffiksv1.Application) error {
-	// if obj.Spec.Resources == nil && a.Defaults != nil && a.Defaults.Resources != nil {
-	// 	span := tracing.Get(ctx)
-	// 	span.AddEvent("add_default_resources")
-	// 	obj.Spec.Resources = a.Defaults.Resources.DeepCopy()
-	// }
+	if obj.Spec.Resources == nil && a.Defaults != nil && a.Defaults.Resources != nil {
+		span := tracing.Get(ctx)
+		span.AddEvent("add_default_resources")
+		obj.Spec.Resources = a.Defaults.Resources.DeepCopy()
+	}
+	if a.Defaults != nil && len(a.Defaults.Labels) > 0 {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		for k, v := range a.Defaults.Labels {
+			if _, ok := labels[k]; !ok {
+				labels[k] = v
+			}
+		}
+		obj.SetLabels(labels)
+	}
 	return nil
 }
 
-func (a *AppReconciler) newDeployment(app *suffiksv1.Application, spec suffiksv1.ApplicationSpec) *appsv1.Deployment {
-	labels := map[string]string{
-		"app.kubernetes.io/name": app.Name,
-	}
+func (a *AppReconciler) Validate(ctx context.Context, obj *suffiksv1.Application) field.ErrorList {
+	return obj.Spec.Resources.Validate(field.NewPath("spec", "resources"))
+}
+
+func (a *AppReconciler) newDeployment(app *suffiksv1.Application, spec suffiksv1.ApplicationSpec) (*appsv1.Deployment, error) {
+	labels := selectorLabels(app)
 
+	exposed := exposedPorts(spec)
 	ports := []corev1.ContainerPort{}
-	if spec.Port > 0 {
+	for _, p := range exposed {
 		ports = append(ports, corev1.ContainerPort{
-			Name:          "http",
-			ContainerPort: int32(spec.Port),
+			Name:          p.name,
+			ContainerPort: p.containerPort,
+			Protocol:      p.protocol,
 		})
 	}
 
-	rq := corev1.ResourceRequirements{}
-	if spec.Resources != nil {
-		rq = corev1.ResourceRequirements{
-			Limits: corev1.ResourceList{
-				corev1.ResourceMemory: spec.Resources.Limits.Memory,
-			},
-			Requests: corev1.ResourceList{
-				corev1.ResourceMemory: spec.Resources.Requests.Memory,
-				corev1.ResourceCPU:    spec.Resources.Requests.CPU,
-			},
-		}
+	// Probes use the first port of the application by default.
+	probePort := 0
+	if len(exposed) > 0 {
+		probePort = int(exposed[0].containerPort)
+	}
+
+	rq := resourceRequirements(spec.Resources)
+
+	var probes suffiksv1.Probes
+	if spec.Probes != nil {
+		probes = *spec.Probes
+	}
+	liveness, err := containerProbe(probes.Liveness, probePort)
+	if err != nil {
+		return nil, fmt.Errorf("liveness probe: %w", err)
+	}
+	readiness, err := containerProbe(probes.Readiness, probePort)
+	if err != nil {
+		return nil, fmt.Errorf("readiness probe: %w", err)
+	}
+	startup, err := containerProbe(probes.Startup, probePort)
+	if err != nil {
+		return nil, fmt.Errorf("startup probe: %w", err)
 	}
 
 	return &appsv1.Deployment{
 		ObjectMeta: a.objectMeta(app),
 		Spec: appsv1.DeploymentSpec{
-			Replicas: ptr.To[int32](1),
+			Replicas: replicas(spec),
+			Strategy: deploymentStrategy(spec.Strategy),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: labels,
 			},
@@ -289,10 +437,211 @@ func (a *AppReconciler) newDeployment(app *suffiksv1.Application, spec suffiksv1
 							Resources: rq,
 							Env:       envVars(spec.Env),
 							EnvFrom:   envFroms(spec.EnvFrom),
+
+							VolumeMounts: volumeMounts(spec.VolumeMounts),
+
+							LivenessProbe:  liveness,
+							ReadinessProbe: readiness,
+							StartupProbe:   startup,
 						},
 					},
+					Volumes: volumes(spec.Volumes),
+				},
+			},
+		},
+	}, nil
+}
+
+// newService returns the Service exposing the ports of the application, or
+// nil if the application doesn't expose any ports.
+func (a *AppReconciler) newService(app *suffiksv1.Application, spec suffiksv1.ApplicationSpec) *corev1.Service {
+	exposed := exposedPorts(spec)
+	if len(exposed) == 0 {
+		return nil
+	}
+
+	ports := make([]corev1.ServicePort, 0, len(exposed))
+	for _, p := range exposed {
+		sp := corev1.ServicePort{
+			Name:       p.name,
+			Port:       p.servicePort,
+			TargetPort: p.targetPort,
+			Protocol:   p.protocol,
+		}
+		if p.appProtocol != "" {
+			sp.AppProtocol = ptr.To(p.appProtocol)
+		}
+		ports = append(ports, sp)
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: a.objectMeta(app),
+		Spec: corev1.ServiceSpec{
+			Ports:    ports,
+			Selector: selectorLabels(app),
+		},
+	}
+	if spec.Service != nil {
+		svc.Annotations = spec.Service.Annotations
+		svc.Spec.Type = corev1.ServiceType(spec.Service.Type)
+	}
+	return svc
+}
+
+// selectorLabels returns the labels selecting the pods of the application.
+func selectorLabels(app *suffiksv1.Application) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name": app.Name,
+	}
+}
+
+// exposedPort is a port exposed by the container, and by the Service.
+type exposedPort struct {
+	name          string
+	containerPort int32
+	servicePort   int32
+	targetPort    intstr.IntOrString
+	protocol      corev1.Protocol
+	appProtocol   string
+}
+
+// exposedPorts returns the ports of the application. For backward
+// compatibility, `port` is exposed as a port named http on port 80 of the
+// Service, targeting the container port by number. Other ports target the
+// container port by name.
+func exposedPorts(spec suffiksv1.ApplicationSpec) []exposedPort {
+	var ports []exposedPort
+	if spec.Port > 0 {
+		ports = append(ports, exposedPort{
+			name:          "http",
+			containerPort: int32(spec.Port),
+			servicePort:   80,
+			targetPort:    intstr.FromInt(spec.Port),
+		})
+	}
+
+	for _, p := range spec.Ports {
+		ports = append(ports, exposedPort{
+			name:          p.Name,
+			containerPort: p.Port,
+			servicePort:   p.Port,
+			targetPort:    intstr.FromString(p.Name),
+			protocol:      corev1.Protocol(p.Protocol),
+			appProtocol:   p.AppProtocol,
+		})
+	}
+	return ports
+}
+
+// containerProbe converts probe to a container probe. Probes not specifying a
+// port use the port of the application.
+func containerProbe(probe *suffiksv1.Probe, appPort int) (*corev1.Probe, error) {
+	if probe == nil {
+		return nil, nil
+	}
+
+	p := &corev1.Probe{
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		SuccessThreshold:    probe.SuccessThreshold,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+
+	if len(probe.Exec) > 0 {
+		p.Exec = &corev1.ExecAction{Command: probe.Exec}
+		return p, nil
+	}
+
+	port := probe.Port
+	if port == 0 {
+		port = appPort
+	}
+	if port == 0 {
+		return nil, fmt.Errorf("port is required when the application has no port")
+	}
+
+	switch {
+	case probe.GRPC != nil:
+		p.GRPC = &corev1.GRPCAction{Port: int32(port)}
+		if probe.GRPC.Service != "" {
+			p.GRPC.Service = ptr.To(probe.GRPC.Service)
+		}
+	case probe.TCP:
+		p.TCPSocket = &corev1.TCPSocketAction{Port: intstr.FromInt(port)}
+	default:
+		p.HTTPGet = &corev1.HTTPGetAction{Path: probe.Path, Port: intstr.FromInt(port)}
+	}
+	return p, nil
+}
+
+// replicas returns the replicas of the Deployment. When autoscaling is
+// enabled, or no replicas are specified, it's left unset so it's owned by
+// whoever scales the Deployment. The API server defaults it to 1 when the
+// Deployment is created. The replicas of an existing Deployment are kept
+// when autoscaling is enabled, see handoverReplicas.
+func replicas(spec suffiksv1.ApplicationSpec) *int32 {
+	if spec.Autoscaling != nil {
+		return nil
+	}
+	return spec.Replicas
+}
+
+func deploymentStrategy(strategy *suffiksv1.Strategy) appsv1.DeploymentStrategy {
+	if strategy == nil {
+		return appsv1.DeploymentStrategy{}
+	}
+
+	if strategy.Type == string(appsv1.RecreateDeploymentStrategyType) {
+		return appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}
+	}
+
+	ds := appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+	if strategy.MaxSurge != nil || strategy.MaxUnavailable != nil {
+		ds.RollingUpdate = &appsv1.RollingUpdateDeployment{
+			MaxSurge:       strategy.MaxSurge,
+			MaxUnavailable: strategy.MaxUnavailable,
+		}
+	}
+	return ds
+}
+
+func (a *AppReconciler) newHPA(app *suffiksv1.Application, autoscaling *suffiksv1.Autoscaling) *autoscalingv2.HorizontalPodAutoscaler {
+	metric := func(name corev1.ResourceName, utilization *int32) autoscalingv2.MetricSpec {
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: name,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: utilization,
 				},
 			},
+		}
+	}
+
+	var metrics []autoscalingv2.MetricSpec
+	if autoscaling.CPU != nil {
+		metrics = append(metrics, metric(corev1.ResourceCPU, autoscaling.CPU))
+	}
+	if autoscaling.Memory != nil {
+		metrics = append(metrics, metric(corev1.ResourceMemory, autoscaling.Memory))
+	}
+	if len(metrics) == 0 {
+		metrics = append(metrics, metric(corev1.ResourceCPU, ptr.To[int32](80)))
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: a.objectMeta(app),
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       app.Name,
+			},
+			MinReplicas: autoscaling.MinReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
 		},
 	}
 }
@@ -323,6 +672,52 @@ func envFroms(froms []suffiksv1.EnvFrom) []corev1.EnvFromSource {
 	return result
 }
 
+func volumes(vols []suffiksv1.Volume) []corev1.Volume {
+	if len(vols) == 0 {
+		return nil
+	}
+
+	result := make([]corev1.Volume, 0, len(vols))
+	for _, vol := range vols {
+		v := corev1.Volume{Name: vol.Name}
+		switch {
+		case vol.ConfigMap != "":
+			v.ConfigMap = &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: vol.ConfigMap},
+			}
+		case vol.Secret != "":
+			v.Secret = &corev1.SecretVolumeSource{SecretName: vol.Secret}
+		case vol.PersistentVolumeClaim != "":
+			v.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{ClaimName: vol.PersistentVolumeClaim}
+		default:
+			v.EmptyDir = &corev1.EmptyDirVolumeSource{}
+			if vol.EmptyDir != nil {
+				v.EmptyDir.Medium = corev1.StorageMedium(vol.EmptyDir.Medium)
+				v.EmptyDir.SizeLimit = vol.EmptyDir.SizeLimit
+			}
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func volumeMounts(mounts []suffiksv1.VolumeMount) []corev1.VolumeMount {
+	if len(mounts) == 0 {
+		return nil
+	}
+
+	result := make([]corev1.VolumeMount, 0, len(mounts))
+	for _, m := range mounts {
+		result = append(result, corev1.VolumeMount{
+			Name:      m.Name,
+			MountPath: m.MountPath,
+			SubPath:   m.SubPath,
+			ReadOnly:  m.ReadOnly,
+		})
+	}
+	return result
+}
+
 func envVars(vars suffiksv1.EnvVars) []corev1.EnvVar {
 	result := make([]corev1.EnvVar, 0, len(vars))
 	for _, env := range vars {
@@ -352,3 +747,37 @@ func (a *AppReconciler) objectMeta(app *suffiksv1.Application) metav1.ObjectMeta
 		Namespace: app.Namespace,
 	}
 }
+
+// resourceRequirements converts resources to the container resource requirements.
+// Extended resources are requested with the same quantity as their limit.
+func resourceRequirements(resources *suffiksv1.ResourceRequirements) corev1.ResourceRequirements {
+	if resources == nil {
+		return corev1.ResourceRequirements{}
+	}
+
+	limits := corev1.ResourceList{
+		corev1.ResourceMemory: resources.Limits.Memory,
+	}
+	requests := corev1.ResourceList{
+		corev1.ResourceMemory: resources.Requests.Memory,
+		corev1.ResourceCPU:    resources.Requests.CPU,
+	}
+	if resources.Limits.CPU != nil {
+		limits[corev1.ResourceCPU] = *resources.Limits.CPU
+	}
+	if resources.Limits.EphemeralStorage != nil {
+		limits[corev1.ResourceEphemeralStorage] = *resources.Limits.EphemeralStorage
+	}
+	if resources.Requests.EphemeralStorage != nil {
+		requests[corev1.ResourceEphemeralStorage] = *resources.Requests.EphemeralStorage
+	}
+	for name, q := range resources.Limits.Extended {
+		limits[corev1.ResourceName(name)] = q
+		requests[corev1.ResourceName(name)] = q
+	}
+
+	return corev1.ResourceRequirements{
+		Limits:   limits,
+		Requests: requests,
+	}
+}
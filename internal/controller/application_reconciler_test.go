@@ -0,0 +1,465 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suffiks/suffiks/extension/protogen"
+	"github.com/suffiks/suffiks/internal/extension"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAppReconciler_newDeployment(t *testing.T) {
+	surge := intstr.FromString("50%")
+
+	tests := map[string]struct {
+		spec     suffiksv1.ApplicationSpec
+		replicas *int32
+		strategy appsv1.DeploymentStrategy
+	}{
+		"defaults": {},
+		"replicas": {
+			spec:     suffiksv1.ApplicationSpec{Replicas: ptr.To[int32](3)},
+			replicas: ptr.To[int32](3),
+		},
+		"replicas are left to the autoscaler": {
+			spec: suffiksv1.ApplicationSpec{
+				Replicas:    ptr.To[int32](3),
+				Autoscaling: &suffiksv1.Autoscaling{MaxReplicas: 5},
+			},
+		},
+		"rolling update": {
+			spec: suffiksv1.ApplicationSpec{Strategy: &suffiksv1.Strategy{MaxSurge: &surge}},
+			strategy: appsv1.DeploymentStrategy{
+				Type:          appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{MaxSurge: &surge},
+			},
+		},
+		"recreate": {
+			spec:     suffiksv1.ApplicationSpec{Strategy: &suffiksv1.Strategy{Type: "Recreate"}},
+			strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+		},
+	}
+
+	a := &AppReconciler{}
+	app := &suffiksv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			depl, err := a.newDeployment(app, tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.replicas, depl.Spec.Replicas); diff != "" {
+				t.Errorf("unexpected replicas (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.strategy, depl.Spec.Strategy); diff != "" {
+				t.Errorf("unexpected strategy (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppReconciler_newHPA(t *testing.T) {
+	utilization := func(name corev1.ResourceName, v int32) autoscalingv2.MetricSpec {
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name:   name,
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: ptr.To(v)},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		autoscaling suffiksv1.Autoscaling
+		metrics     []autoscalingv2.MetricSpec
+	}{
+		"default target": {
+			autoscaling: suffiksv1.Autoscaling{MaxReplicas: 5},
+			metrics:     []autoscalingv2.MetricSpec{utilization(corev1.ResourceCPU, 80)},
+		},
+		"cpu and memory": {
+			autoscaling: suffiksv1.Autoscaling{MinReplicas: ptr.To[int32](2), MaxReplicas: 5, CPU: ptr.To[int32](60), Memory: ptr.To[int32](70)},
+			metrics:     []autoscalingv2.MetricSpec{utilization(corev1.ResourceCPU, 60), utilization(corev1.ResourceMemory, 70)},
+		},
+	}
+
+	a := &AppReconciler{}
+	app := &suffiksv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			hpa := a.newHPA(app, &tc.autoscaling)
+
+			expected := autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app"},
+				MinReplicas:    tc.autoscaling.MinReplicas,
+				MaxReplicas:    tc.autoscaling.MaxReplicas,
+				Metrics:        tc.metrics,
+			}
+			if diff := cmp.Diff(expected, hpa.Spec); diff != "" {
+				t.Errorf("unexpected hpa spec (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestContainerProbe(t *testing.T) {
+	tests := map[string]struct {
+		probe   *suffiksv1.Probe
+		appPort int
+		want    *corev1.Probe
+		wantErr bool
+	}{
+		"nil": {},
+		"path uses application port": {
+			probe:   &suffiksv1.Probe{Path: "/healthz", FailureThreshold: 5},
+			appPort: 8080,
+			want: &corev1.Probe{
+				ProbeHandler:     corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)}},
+				FailureThreshold: 5,
+			},
+		},
+		"tcp with own port": {
+			probe:   &suffiksv1.Probe{TCP: true, Port: 9090, PeriodSeconds: 30},
+			appPort: 8080,
+			want: &corev1.Probe{
+				ProbeHandler:  corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(9090)}},
+				PeriodSeconds: 30,
+			},
+		},
+		"grpc": {
+			probe:   &suffiksv1.Probe{GRPC: &suffiksv1.GRPCProbe{Service: "app"}},
+			appPort: 8080,
+			want: &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{GRPC: &corev1.GRPCAction{Port: 8080, Service: ptr.To("app")}},
+			},
+		},
+		"exec without port": {
+			probe: &suffiksv1.Probe{Exec: []string{"cat", "/tmp/ready"}},
+			want: &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"cat", "/tmp/ready"}}},
+			},
+		},
+		"missing port": {
+			probe:   &suffiksv1.Probe{Path: "/healthz"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := containerProbe(tc.probe, tc.appPort)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppReconciler_newService(t *testing.T) {
+	selector := map[string]string{"app.kubernetes.io/name": "app"}
+
+	tests := map[string]struct {
+		spec suffiksv1.ApplicationSpec
+		want *corev1.Service
+	}{
+		"no ports": {},
+		"port": {
+			spec: suffiksv1.ApplicationSpec{Port: 8080},
+			want: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Ports:    []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)}},
+					Selector: selector,
+				},
+			},
+		},
+		"port and named ports": {
+			spec: suffiksv1.ApplicationSpec{
+				Port: 8080,
+				Ports: []suffiksv1.Port{
+					{Name: "grpc", Port: 9090, AppProtocol: "h2c"},
+					{Name: "metrics", Port: 9100, Protocol: "TCP"},
+				},
+				Service: &suffiksv1.Service{
+					Type:        "LoadBalancer",
+					Annotations: map[string]string{"example.com/internal": "true"},
+				},
+			},
+			want: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "app",
+					Namespace:   "default",
+					Annotations: map[string]string{"example.com/internal": "true"},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)},
+						{Name: "grpc", Port: 9090, TargetPort: intstr.FromString("grpc"), AppProtocol: ptr.To("h2c")},
+						{Name: "metrics", Port: 9100, TargetPort: intstr.FromString("metrics"), Protocol: corev1.ProtocolTCP},
+					},
+					Selector: selector,
+				},
+			},
+		},
+	}
+
+	a := &AppReconciler{}
+	app := &suffiksv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, a.newService(app, tc.spec)); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+
+			depl, err := a.newDeployment(app, tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := len(depl.Spec.Template.Spec.Containers[0].Ports), len(exposedPorts(tc.spec)); got != want {
+				t.Errorf("expected %d container ports, got %d", want, got)
+			}
+		})
+	}
+}
+
+func TestResourceRequirements(t *testing.T) {
+	tests := map[string]struct {
+		resources *suffiksv1.ResourceRequirements
+		want      corev1.ResourceRequirements
+	}{
+		"nil": {},
+		"memory and cpu": {
+			resources: &suffiksv1.ResourceRequirements{
+				Limits:   suffiksv1.ResourceRequirementsLimits{Memory: resource.MustParse("256Mi")},
+				Requests: suffiksv1.ResourceRequirementsRequests{CPU: resource.MustParse("100m"), Memory: resource.MustParse("128Mi")},
+			},
+			want: corev1.ResourceRequirements{
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			},
+		},
+		"all": {
+			resources: &suffiksv1.ResourceRequirements{
+				Limits: suffiksv1.ResourceRequirementsLimits{
+					CPU:              ptr.To(resource.MustParse("1")),
+					Memory:           resource.MustParse("256Mi"),
+					EphemeralStorage: ptr.To(resource.MustParse("2Gi")),
+					Extended:         map[string]resource.Quantity{"nvidia.com/gpu": resource.MustParse("1")},
+				},
+				Requests: suffiksv1.ResourceRequirementsRequests{
+					CPU:              resource.MustParse("100m"),
+					Memory:           resource.MustParse("128Mi"),
+					EphemeralStorage: ptr.To(resource.MustParse("1Gi")),
+				},
+			},
+			want: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("1"),
+					corev1.ResourceMemory:           resource.MustParse("256Mi"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+					"nvidia.com/gpu":                resource.MustParse("1"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("100m"),
+					corev1.ResourceMemory:           resource.MustParse("128Mi"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+					"nvidia.com/gpu":                resource.MustParse("1"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := resourceRequirements(tc.resources)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppReconciler_Default(t *testing.T) {
+	defaults := &suffiksv1.ApplicationDefaults{
+		Resources: &suffiksv1.ResourceRequirements{
+			Limits:   suffiksv1.ResourceRequirementsLimits{Memory: resource.MustParse("256Mi")},
+			Requests: suffiksv1.ResourceRequirementsRequests{CPU: resource.MustParse("100m"), Memory: resource.MustParse("128Mi")},
+		},
+		Labels: map[string]string{"team": "platform"},
+	}
+	own := &suffiksv1.ResourceRequirements{
+		Limits:   suffiksv1.ResourceRequirementsLimits{Memory: resource.MustParse("1Gi")},
+		Requests: suffiksv1.ResourceRequirementsRequests{CPU: resource.MustParse("1"), Memory: resource.MustParse("1Gi")},
+	}
+
+	tests := map[string]struct {
+		defaults   *suffiksv1.ApplicationDefaults
+		resources  *suffiksv1.ResourceRequirements
+		labels     map[string]string
+		want       *suffiksv1.ResourceRequirements
+		wantLabels map[string]string
+	}{
+		"no defaults":   {},
+		"defaulted":     {defaults: defaults, want: defaults.Resources, wantLabels: map[string]string{"team": "platform"}},
+		"own resources": {defaults: defaults, resources: own, want: own, wantLabels: map[string]string{"team": "platform"}},
+		"own labels": {
+			defaults:   defaults,
+			labels:     map[string]string{"team": "apps", "app": "foo"},
+			want:       defaults.Resources,
+			wantLabels: map[string]string{"team": "apps", "app": "foo"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := &suffiksv1.Application{Spec: suffiksv1.ApplicationSpec{Resources: tc.resources}}
+			app.SetLabels(tc.labels)
+			if err := (&AppReconciler{Defaults: tc.defaults}).Default(context.Background(), app); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, app.Spec.Resources); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantLabels, app.GetLabels()); diff != "" {
+				t.Errorf("labels -want +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppReconciler_newDeploymentVolumes(t *testing.T) {
+	spec := suffiksv1.ApplicationSpec{
+		Volumes: []suffiksv1.Volume{
+			{Name: "config", ConfigMap: "app-config"},
+			{Name: "cache", EmptyDir: &suffiksv1.EmptyDirVolume{Medium: "Memory"}},
+		},
+		VolumeMounts: []suffiksv1.VolumeMount{
+			{Name: "config", MountPath: "/etc/app", ReadOnly: true},
+		},
+	}
+	volume := func(name, secret string) *protogen.Response {
+		return &protogen.Response{OFResponse: &protogen.Response_Volume{Volume: &protogen.Volume{
+			Name:   name,
+			Secret: &protogen.SecretVolumeSource{SecretName: secret},
+		}}}
+	}
+
+	tests := map[string]struct {
+		responses []*protogen.Response
+		want      []string
+		wantErr   bool
+	}{
+		"spec volumes":       {want: []string{"config", "cache"}},
+		"extension volumes":  {responses: []*protogen.Response{volume("cert", "app-cert")}, want: []string{"config", "cache", "cert"}},
+		"conflicting volume": {responses: []*protogen.Response{volume("config", "app-cert")}, wantErr: true},
+	}
+
+	a := &AppReconciler{}
+	app := &suffiksv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			depl, err := a.newDeployment(app, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changeset := &extension.Changeset{}
+			for _, resp := range tc.responses {
+				if err := changeset.Add(resp); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err = changeset.Apply(depl)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pod := depl.Spec.Template.Spec
+			var got []string
+			for _, v := range pod.Volumes {
+				got = append(got, v.Name)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected volumes (-want +got):\n%s", diff)
+			}
+			want := []corev1.VolumeMount{{Name: "config", MountPath: "/etc/app", ReadOnly: true}}
+			if diff := cmp.Diff(want, pod.Containers[0].VolumeMounts); diff != "" {
+				t.Errorf("unexpected volume mounts (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppReconciler_deleteService(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := suffiksv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	app := &suffiksv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", UID: "uid"}}
+
+	tests := map[string]struct {
+		owners  []metav1.OwnerReference
+		deleted bool
+	}{
+		"controlled": {
+			owners:  []metav1.OwnerReference{{APIVersion: "suffiks.com/v1", Kind: "Application", Name: "app", UID: "uid", Controller: ptr.To(true)}},
+			deleted: true,
+		},
+		"not controlled": {},
+		"controlled by another object": {
+			owners: []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "app", UID: "other", Controller: ptr.To(true)}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", OwnerReferences: tc.owners}}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(svc).Build()
+			a := &AppReconciler{Scheme: scheme, Client: c}
+
+			if err := a.deleteService(ctx, app); err != nil {
+				t.Fatal(err)
+			}
+
+			err := c.Get(ctx, client.ObjectKeyFromObject(svc), &corev1.Service{})
+			if deleted := errors.IsNotFound(err); deleted != tc.deleted {
+				t.Errorf("expected deleted to be %v, got %v (%v)", tc.deleted, deleted, err)
+			}
+		})
+	}
+
+	// A missing Service is not an error.
+	a := &AppReconciler{Scheme: scheme, Client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	if err := a.deleteService(context.Background(), app); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// fieldManager is the field manager used when applying resources.
+const fieldManager = "suffiks"
+
+// handoverFieldManager is the field manager keeping the replicas of a
+// Deployment while they're handed over to its HPA.
+const handoverFieldManager = "suffiks-handover"
+
+// legacyFieldManagers are the field managers of earlier versions of suffiks,
+// which created and updated resources using client side updates.
+var legacyFieldManagers = sets.New("manager")
+
+// serverSideApply creates or updates obj using server-side apply.
+//
+// Fields owned by other field managers are left untouched. Applying a
+// different value to one of them fails with a conflict, which can be
+// checked using errors.IsConflict, unless ctx is created by
+// withForceOwnership, as done when repairing drift.
+func serverSideApply(ctx context.Context, c client.Client, scheme *runtime.Scheme, obj client.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return fmt.Errorf("serverSideApply: %w", err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+
+	if err := upgradeManagedFields(ctx, c, obj); err != nil {
+		return fmt.Errorf("serverSideApply: upgrade managed fields: %w", err)
+	}
+
+	opts := []client.PatchOption{client.FieldOwner(fieldManager)}
+	if forceOwnership(ctx) {
+		opts = append(opts, client.ForceOwnership)
+	}
+	return c.Patch(ctx, obj, client.Apply, opts...)
+}
+
+type forceOwnershipKey struct{}
+
+// withForceOwnership returns a context in which serverSideApply takes
+// ownership of conflicting fields instead of failing.
+func withForceOwnership(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceOwnershipKey{}, true)
+}
+
+func forceOwnership(ctx context.Context) bool {
+	force, _ := ctx.Value(forceOwnershipKey{}).(bool)
+	return force
+}
+
+// upgradeManagedFields moves ownership of fields set by legacyFieldManagers
+// to fieldManager, so they can be changed or removed by the next apply.
+func upgradeManagedFields(ctx context.Context, c client.Client, obj client.Object) error {
+	existing, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unexpected type %T", obj)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing, legacyFieldManagers, fieldManager)
+	if err != nil || patch == nil {
+		return err
+	}
+	return c.Patch(ctx, existing, client.RawPatch(types.JSONPatchType, patch))
+}
@@ -0,0 +1,226 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/suffiks/suffiks/internal/waruntime"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logr "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// maxConditionMessage limits the length of condition messages, as errors from
+// extensions might contain full stack traces.
+const maxConditionMessage = 1024
+
+// truncateMessage shortens msg to at most maxConditionMessage bytes, without
+// splitting a multi-byte character, as the API server rejects invalid UTF-8.
+func truncateMessage(msg string) string {
+	if len(msg) <= maxConditionMessage {
+		return msg
+	}
+	n := maxConditionMessage
+	for n > 0 && !utf8.RuneStart(msg[n]) {
+		n--
+	}
+	return msg[:n]
+}
+
+// conditionsObject is implemented by objects reporting conditions in their status.
+type conditionsObject interface {
+	GetConditions() []metav1.Condition
+	SetConditions([]metav1.Condition)
+}
+
+// setCondition sets the condition on obj if it supports conditions.
+// It returns true if the status, reason or message of the condition changed.
+func setCondition(obj Object, condition metav1.Condition) bool {
+	co, ok := obj.(conditionsObject)
+	if !ok {
+		return false
+	}
+
+	condition.ObservedGeneration = obj.GetGeneration()
+	condition.Message = truncateMessage(condition.Message)
+
+	conditions := co.GetConditions()
+	existing := meta.FindStatusCondition(conditions, condition.Type)
+	changed := existing == nil ||
+		existing.Status != condition.Status ||
+		existing.Reason != condition.Reason ||
+		existing.Message != condition.Message ||
+		existing.ObservedGeneration != condition.ObservedGeneration
+
+	meta.SetStatusCondition(&conditions, condition)
+	co.SetConditions(conditions)
+	return changed
+}
+
+// setSyncedCondition sets the Synced condition based on the result of syncing extensions.
+func setSyncedCondition(obj Object, err error) bool {
+	if err == nil {
+		return setCondition(obj, metav1.Condition{
+			Type:    suffiksv1.ConditionSynced,
+			Status:  metav1.ConditionTrue,
+			Reason:  suffiksv1.ReasonSynced,
+			Message: "All extensions synced successfully",
+		})
+	}
+
+	reason := suffiksv1.ReasonExtensionFailed
+	var (
+		panicErr *PanicError
+		hostErr  *waruntime.HostError
+	)
+	if errors.As(err, &panicErr) || errors.As(err, &hostErr) {
+		reason = suffiksv1.ReasonExtensionPanic
+	}
+
+	return setCondition(obj, metav1.Condition{
+		Type:    suffiksv1.ConditionSynced,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: err.Error(),
+	})
+}
+
+// setIgnoredFailuresCondition sets the Synced condition when the sync
+// succeeded, but failures of extensions with failurePolicy Ignore were ignored.
+func setIgnoredFailuresCondition(obj Object, warnings []string) bool {
+	warnings = slices.Clone(warnings)
+	slices.Sort(warnings)
+	return setCondition(obj, metav1.Condition{
+		Type:    suffiksv1.ConditionSynced,
+		Status:  metav1.ConditionTrue,
+		Reason:  suffiksv1.ReasonExtensionFailureIgnored,
+		Message: strings.Join(warnings, "\n"),
+	})
+}
+
+// setApplySkippedCondition sets the Synced condition when the resources of obj
+// weren't applied, as that would remove the changes of extensions whose
+// failures were ignored.
+func setApplySkippedCondition(obj Object, warnings []string) bool {
+	warnings = slices.Clone(warnings)
+	slices.Sort(warnings)
+	return setCondition(obj, metav1.Condition{
+		Type:    suffiksv1.ConditionSynced,
+		Status:  metav1.ConditionFalse,
+		Reason:  suffiksv1.ReasonApplySkipped,
+		Message: "Resources are kept as is until the failed extensions succeed again:\n" + strings.Join(warnings, "\n"),
+	})
+}
+
+// setApplyFailedCondition sets the Synced condition when applying the
+// resources of obj failed. Conflicts with fields owned by other field managers
+// are reported using their own reason.
+func setApplyFailedCondition(obj Object, err error) bool {
+	reason := suffiksv1.ReasonApplyFailed
+	if apierrors.IsConflict(err) {
+		reason = suffiksv1.ReasonApplyConflict
+	}
+
+	return setCondition(obj, metav1.Condition{
+		Type:    suffiksv1.ConditionSynced,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: err.Error(),
+	})
+}
+
+// updateConditions sets the ExtensionsHealthy, Progressing and Ready conditions of v.
+// The Synced condition is expected to be set before calling it.
+func (r *ReconcilerWrapper[V]) updateConditions(ctx context.Context, v V) bool {
+	healthy := metav1.Condition{
+		Type:    suffiksv1.ConditionExtensionsHealthy,
+		Status:  metav1.ConditionTrue,
+		Reason:  suffiksv1.ReasonHealthy,
+		Message: "All extensions are able to serve requests",
+	}
+	if unhealthy := r.CRDController.Unhealthy(v, r.Child.Extensions(v)); len(unhealthy) > 0 {
+		healthy.Status = metav1.ConditionFalse
+		healthy.Reason = suffiksv1.ReasonExtensionUnavailable
+		healthy.Message = "Unavailable extensions: " + strings.Join(unhealthy, ", ")
+	}
+
+	changed := setCondition(v, healthy)
+	return r.setReadyCondition(ctx, v) || changed
+}
+
+// setReadyCondition sets the Progressing condition from the workload of v, if
+// the reconciler is able to report it, and the Ready condition from the other
+// conditions of v.
+func (r *ReconcilerWrapper[V]) setReadyCondition(ctx context.Context, v V) bool {
+	co, ok := any(v).(conditionsObject)
+	if !ok {
+		return false
+	}
+
+	changed := false
+	workload := WorkloadStatus{Ready: true}
+	if wl, ok := r.Child.Reconciler.(ReconcilerWorkload[V]); ok {
+		var err error
+		workload, err = wl.WorkloadStatus(ctx, v)
+		if err != nil {
+			logr.FromContext(ctx).Error(err, "unable to get workload status")
+			workload = WorkloadStatus{Message: err.Error()}
+		}
+
+		progressing := metav1.Condition{
+			Type:    suffiksv1.ConditionProgressing,
+			Status:  metav1.ConditionFalse,
+			Reason:  suffiksv1.ReasonComplete,
+			Message: workload.Message,
+		}
+		if workload.Progressing {
+			progressing.Status = metav1.ConditionTrue
+			progressing.Reason = suffiksv1.ReasonProgressing
+		}
+		changed = setCondition(v, progressing)
+	}
+
+	return setCondition(v, readyCondition(co.GetConditions(), workload)) || changed
+}
+
+// readyCondition returns the Ready condition summarizing conditions and the
+// state of the workload.
+func readyCondition(conditions []metav1.Condition, workload WorkloadStatus) metav1.Condition {
+	ready := metav1.Condition{
+		Type:    suffiksv1.ConditionReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  suffiksv1.ReasonNotSynced,
+		Message: "Waiting for extensions to sync",
+	}
+
+	synced := meta.FindStatusCondition(conditions, suffiksv1.ConditionSynced)
+	if synced == nil {
+		return ready
+	}
+	if synced.Status != metav1.ConditionTrue {
+		ready.Message = synced.Message
+		return ready
+	}
+
+	if healthy := meta.FindStatusCondition(conditions, suffiksv1.ConditionExtensionsHealthy); healthy != nil && healthy.Status == metav1.ConditionFalse {
+		ready.Reason = suffiksv1.ReasonExtensionUnavailable
+		ready.Message = healthy.Message
+		return ready
+	}
+
+	if !workload.Ready {
+		ready.Reason = suffiksv1.ReasonWorkloadNotReady
+		ready.Message = workload.Message
+		return ready
+	}
+
+	ready.Status = metav1.ConditionTrue
+	ready.Reason = suffiksv1.ReasonReady
+	ready.Message = "Ready"
+	return ready
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestReadyCondition(t *testing.T) {
+	synced := metav1.Condition{Type: suffiksv1.ConditionSynced, Status: metav1.ConditionTrue}
+	notSynced := metav1.Condition{Type: suffiksv1.ConditionSynced, Status: metav1.ConditionFalse, Message: "sync failed"}
+	unhealthy := metav1.Condition{Type: suffiksv1.ConditionExtensionsHealthy, Status: metav1.ConditionFalse, Message: "Unavailable extensions: ingress"}
+
+	tests := map[string]struct {
+		conditions []metav1.Condition
+		workload   WorkloadStatus
+		status     metav1.ConditionStatus
+		reason     string
+		message    string
+	}{
+		"not synced yet": {
+			workload: WorkloadStatus{Ready: true},
+			status:   metav1.ConditionFalse,
+			reason:   suffiksv1.ReasonNotSynced,
+			message:  "Waiting for extensions to sync",
+		},
+		"sync failed": {
+			conditions: []metav1.Condition{notSynced},
+			workload:   WorkloadStatus{Ready: true},
+			status:     metav1.ConditionFalse,
+			reason:     suffiksv1.ReasonNotSynced,
+			message:    "sync failed",
+		},
+		"extension unavailable": {
+			conditions: []metav1.Condition{synced, unhealthy},
+			workload:   WorkloadStatus{Ready: true},
+			status:     metav1.ConditionFalse,
+			reason:     suffiksv1.ReasonExtensionUnavailable,
+			message:    "Unavailable extensions: ingress",
+		},
+		"workload not ready": {
+			conditions: []metav1.Condition{synced},
+			workload:   WorkloadStatus{Message: "0/1 replicas available"},
+			status:     metav1.ConditionFalse,
+			reason:     suffiksv1.ReasonWorkloadNotReady,
+			message:    "0/1 replicas available",
+		},
+		"ready": {
+			conditions: []metav1.Condition{synced},
+			workload:   WorkloadStatus{Ready: true},
+			status:     metav1.ConditionTrue,
+			reason:     suffiksv1.ReasonReady,
+			message:    "Ready",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := readyCondition(tc.conditions, tc.workload)
+			if got.Type != suffiksv1.ConditionReady {
+				t.Errorf("expected type %q, got %q", suffiksv1.ConditionReady, got.Type)
+			}
+			if got.Status != tc.status || got.Reason != tc.reason || got.Message != tc.message {
+				t.Errorf("expected %s/%s/%q, got %s/%s/%q", tc.status, tc.reason, tc.message, got.Status, got.Reason, got.Message)
+			}
+		})
+	}
+}
+
+func TestSetApplyFailedCondition(t *testing.T) {
+	tests := map[string]struct {
+		err    error
+		reason string
+	}{
+		"conflict": {
+			err:    fmt.Errorf("Reconcile apply deployment: %w", apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "app", errors.New("conflict with \"kubectl\": .spec.replicas"))),
+			reason: suffiksv1.ReasonApplyConflict,
+		},
+		"other": {
+			err:    errors.New("connection refused"),
+			reason: suffiksv1.ReasonApplyFailed,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := &suffiksv1.Application{}
+			if !setApplyFailedCondition(app, tc.err) {
+				t.Fatal("expected condition to change")
+			}
+
+			cond := meta.FindStatusCondition(app.Status.Conditions, suffiksv1.ConditionSynced)
+			if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != tc.reason || cond.Message != tc.err.Error() {
+				t.Errorf("unexpected condition: %+v", cond)
+			}
+		})
+	}
+}
+
+func TestSetCondition(t *testing.T) {
+	app := &suffiksv1.Application{}
+	app.Generation = 1
+	cond := metav1.Condition{Type: suffiksv1.ConditionSynced, Status: metav1.ConditionFalse, Reason: suffiksv1.ReasonApplyFailed, Message: "connection refused"}
+	if !setCondition(app, cond) {
+		t.Fatal("expected new condition to be reported as changed")
+	}
+
+	tests := map[string]struct {
+		change func(c *metav1.Condition)
+		want   bool
+	}{
+		"unchanged": {change: func(*metav1.Condition) {}},
+		"status":    {change: func(c *metav1.Condition) { c.Status = metav1.ConditionTrue }, want: true},
+		"reason":    {change: func(c *metav1.Condition) { c.Reason = suffiksv1.ReasonApplyConflict }, want: true},
+		"message":   {change: func(c *metav1.Condition) { c.Message = "connection reset" }, want: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := app.DeepCopy()
+			c := cond
+			tc.change(&c)
+			if got := setCondition(app, c); got != tc.want {
+				t.Errorf("setCondition() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSetCondition_truncate(t *testing.T) {
+	tests := map[string]struct {
+		message string
+		want    int
+	}{
+		"short": {
+			message: "connection refused",
+			want:    len("connection refused"),
+		},
+		"ascii": {
+			message: strings.Repeat("a", maxConditionMessage+10),
+			want:    maxConditionMessage,
+		},
+		"multi-byte": {
+			// 3 byte characters, where the limit falls in the middle of one.
+			message: strings.Repeat("€", maxConditionMessage),
+			want:    maxConditionMessage / 3 * 3,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := &suffiksv1.Application{}
+			setCondition(app, metav1.Condition{Type: suffiksv1.ConditionSynced, Status: metav1.ConditionFalse, Reason: suffiksv1.ReasonApplyFailed, Message: tc.message})
+
+			got := meta.FindStatusCondition(app.Status.Conditions, suffiksv1.ConditionSynced).Message
+			if len(got) != tc.want {
+				t.Errorf("expected message of %d bytes, got %d", tc.want, len(got))
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("expected valid UTF-8 message, got %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/suffiks/suffiks/extension/protogen"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/lru"
+	logr "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/recorder"
+)
+
+const (
+	// eventBurst is the number of events an extension can record on an object at once.
+	eventBurst = 10
+	// eventQPS is the rate events are allowed at after the burst, per extension and object.
+	eventQPS = 1.0 / 30
+	// eventLimiters is the number of extension and object pairs rate limits are kept for.
+	eventLimiters = 4096
+)
+
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// eventRecorder records the events sent by extensions on the object they run
+// for. Events are rate limited per extension and object, so a misbehaving
+// extension can't flood the API server or drown out the events of others.
+type eventRecorder struct {
+	provider recorder.Provider
+	limiters *lru.Cache
+}
+
+func newEventRecorder(provider recorder.Provider) *eventRecorder {
+	return &eventRecorder{
+		provider: provider,
+		limiters: lru.New(eventLimiters),
+	}
+}
+
+// record records the events in responses on o, using the name of the
+// extension as source.
+func (e *eventRecorder) record(ctx context.Context, o Object, ext string, responses []*protogen.Response) {
+	if e == nil {
+		return
+	}
+
+	for _, resp := range responses {
+		r, ok := resp.OFResponse.(*protogen.Response_Event)
+		if !ok {
+			continue
+		}
+
+		if !e.allow(o, ext) {
+			logr.FromContext(ctx).Info("Dropped rate limited event", "extension", ext, "reason", r.Event.Reason)
+			continue
+		}
+
+		typ := corev1.EventTypeNormal
+		if r.Event.Type == protogen.EventType_WARNING {
+			typ = corev1.EventTypeWarning
+		}
+		e.provider.GetEventRecorderFor("suffiks/"+ext).Event(o, typ, r.Event.Reason, r.Event.Message)
+	}
+}
+
+// allow reports whether ext may record another event on o.
+func (e *eventRecorder) allow(o Object, ext string) bool {
+	key := ext + "/" + string(o.GetUID())
+
+	limiter, ok := e.limiters.Get(key)
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiter(eventQPS, eventBurst)
+		e.limiters.Add(key, limiter)
+	}
+	return limiter.(flowcontrol.RateLimiter).TryAccept()
+}
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suffiks/suffiks/extension/protogen"
+	"github.com/suffiks/suffiks/internal/extension"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+type fakeRecorders map[string]*record.FakeRecorder
+
+func (f fakeRecorders) GetEventRecorderFor(name string) record.EventRecorder {
+	if _, ok := f[name]; !ok {
+		f[name] = record.NewFakeRecorder(100)
+	}
+	return f[name]
+}
+
+func (f fakeRecorders) events(name string) []string {
+	r, ok := f[name]
+	if !ok {
+		return nil
+	}
+
+	var events []string
+	for len(r.Events) > 0 {
+		events = append(events, <-r.Events)
+	}
+	return events
+}
+
+func TestExtensionController_SyncEvents(t *testing.T) {
+	event := func(typ protogen.EventType, reason, message string) *protogen.Response {
+		return &protogen.Response{
+			OFResponse: &protogen.Response_Event{
+				Event: &protogen.Event{Type: typ, Reason: reason, Message: message},
+			},
+		}
+	}
+
+	flood := sliceStream{}
+	for i := 0; i < eventBurst+5; i++ {
+		flood = append(flood, event(protogen.EventType_NORMAL, "Flood", "again"))
+	}
+
+	mgr := mockManager{
+		&mockExtension{
+			name: "ingress",
+			sync: func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+				return &sliceStream{
+					event(protogen.EventType_WARNING, "HostTaken", "ingress host foo.example.com is taken"),
+					event(protogen.EventType_NORMAL, "Created", "ingress created"),
+				}, nil
+			},
+		},
+		&mockExtension{
+			name: "flood",
+			sync: func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+				s := flood
+				return &s, nil
+			},
+		},
+	}
+
+	app := &suffiksv1.Application{
+		TypeMeta:   metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"},
+		ObjectMeta: metav1.ObjectMeta{UID: "app"},
+		Spec:       suffiksv1.ApplicationSpec{Image: "image"},
+	}
+
+	recorders := fakeRecorders{}
+	ctrl := NewExtensionController(mgr)
+	ctrl.SetEventRecorder(recorders)
+
+	sync := func(o Object) {
+		t.Helper()
+		result, err := ctrl.Sync(context.Background(), o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctrl.RecordEvents(context.Background(), o, result)
+	}
+
+	// Events are only recorded once the changeset is applied.
+	result, err := ctrl.Sync(context.Background(), app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(recorders.events("suffiks/ingress")); got != 0 {
+		t.Errorf("expected no events before the changeset is applied, got %d", got)
+	}
+	ctrl.RecordEvents(context.Background(), app, result)
+
+	want := []string{
+		"Warning HostTaken ingress host foo.example.com is taken",
+		"Normal Created ingress created",
+	}
+	if diff := cmp.Diff(want, recorders.events("suffiks/ingress")); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+	if got := len(recorders.events("suffiks/flood")); got != eventBurst {
+		t.Errorf("expected %d events from flood, got %d", eventBurst, got)
+	}
+
+	// The rate limit is kept between syncs, but is separate per object.
+	sync(app)
+	if got := len(recorders.events("suffiks/flood")); got != 0 {
+		t.Errorf("expected no events from flood, got %d", got)
+	}
+
+	other := app.DeepCopy()
+	other.UID = "other"
+	sync(other)
+	if got := len(recorders.events("suffiks/flood")); got != eventBurst {
+		t.Errorf("expected %d events from flood on other object, got %d", eventBurst, got)
+	}
+}
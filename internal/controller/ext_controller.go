@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"reflect"
+	"runtime/debug"
 	"slices"
 	"strings"
 	"sync"
@@ -15,7 +18,15 @@ import (
 	"github.com/suffiks/suffiks/extension/protogen"
 	"github.com/suffiks/suffiks/internal/extension"
 	"github.com/suffiks/suffiks/internal/tracing"
+	"github.com/suffiks/suffiks/internal/waruntime"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logr "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/recorder"
 )
 
 type lockedList[T comparable] struct {
@@ -44,10 +55,29 @@ type Result struct {
 
 	// Extensions contains the name of extensions that were ran during the operation.
 	Extensions lockedList[string]
+
+	// Warnings contains the failures of extensions which were ignored due to their failure policy.
+	Warnings lockedList[string]
+
+	// Ignored contains the name of extensions whose failure was ignored due to
+	// their failure policy. Their responses are not part of the changeset.
+	Ignored lockedList[string]
+
+	// events are the responses of extensions added to the changeset, holding
+	// the events recorded by RecordEvents once the changeset is applied.
+	events []extensionResponses
+}
+
+type extensionResponses struct {
+	extension string
+	responses []*protogen.Response
 }
 
 type ExtManager interface {
-	ExtensionsFor(kind string) []extension.Extension
+	// ExtensionsFor returns the extensions enabled for obj.
+	ExtensionsFor(ctx context.Context, obj client.Object) ([]extension.Extension, error)
+	// ExtensionsForKind returns every extension targeting kind.
+	ExtensionsForKind(kind string) []extension.Extension
 }
 
 type responder interface {
@@ -59,6 +89,39 @@ type (
 	shouldRunFunc func(e extension.Extension, cu *protogen.SyncRequest) bool
 )
 
+// PanicError is returned when an extension panics during an operation.
+type PanicError struct {
+	Extension string
+	Operation string
+	Value     any
+	Stack     []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("extension %q panicked during %s: %v", p.Extension, p.Operation, p.Value)
+}
+
+// recoverExtension converts a panic in the goroutine running an extension into
+// a PanicError, which is recorded on the span, observed as a failure of the
+// operation started at start, and passed to addErr.
+// It must be deferred directly.
+func (c *ExtensionController) recoverExtension(ctx context.Context, operation, extension string, start time.Time, addErr func(error)) {
+	v := recover()
+	if v == nil {
+		return
+	}
+
+	err := &PanicError{
+		Extension: extension,
+		Operation: operation,
+		Value:     v,
+		Stack:     debug.Stack(),
+	}
+	trace.SpanFromContext(ctx).RecordError(err, trace.WithAttributes(attribute.String("stack", string(err.Stack))))
+	c.observeFailure(operation, extension, start, err)
+	addErr(err)
+}
+
 type FieldErrsWrapper field.ErrorList
 
 func (f FieldErrsWrapper) Error() string {
@@ -71,8 +134,10 @@ func (f FieldErrsWrapper) Error() string {
 }
 
 type ExtensionController struct {
-	manager ExtManager
-	metrics *prometheus.HistogramVec
+	manager         ExtManager
+	metrics         *prometheus.HistogramVec
+	limitViolations *prometheus.CounterVec
+	events          *eventRecorder
 }
 
 func NewExtensionController(manager ExtManager) *ExtensionController {
@@ -83,11 +148,35 @@ func NewExtensionController(manager ExtManager) *ExtensionController {
 			Help:    "Duration of extension manager operations",
 			Buckets: []float64{.005, .01, .05, .1, .25, .5, 1, 2.5, 5, 10},
 		}, []string{"operation", "extension", "status"}),
+		limitViolations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "suffiks_extension_manager_limit_violations_total",
+			Help: "Number of extension operations stopped by a WASI limit",
+		}, []string{"operation", "extension", "limit"}),
 	}
 }
 
 func (c *ExtensionController) RegisterMetrics(reg prometheus.Registerer) error {
-	return reg.Register(c.metrics)
+	if err := reg.Register(c.metrics); err != nil {
+		return err
+	}
+	return reg.Register(c.limitViolations)
+}
+
+// SetEventRecorder makes the controller record the events sent by extensions
+// using recorders from provider. Without it, events are dropped.
+func (c *ExtensionController) SetEventRecorder(provider recorder.Provider) {
+	c.events = newEventRecorder(provider)
+}
+
+// observeFailure records the duration of a failed operation, and counts it if
+// it was caused by a WASI limit.
+func (c *ExtensionController) observeFailure(operation, ext string, start time.Time, err error) {
+	c.metrics.WithLabelValues(operation, ext, "failure").Observe(time.Since(start).Seconds())
+
+	var limitErr *waruntime.LimitError
+	if errors.As(err, &limitErr) {
+		c.limitViolations.WithLabelValues(operation, ext, string(limitErr.Limit)).Inc()
+	}
 }
 
 func (c *ExtensionController) Sync(ctx context.Context, v Object) (*Result, error) {
@@ -95,26 +184,74 @@ func (c *ExtensionController) Sync(ctx context.Context, v Object) (*Result, erro
 		return ext.Sync(ctx, in)
 	}
 
-	return c.run(ctx, "sync", v, f, func(e extension.Extension, cu *protogen.SyncRequest) bool {
-		return e.Spec().Always || len(cu.Spec) > 0
-	})
+	return c.run(ctx, "sync", v, f, syncs)
 }
 
-func (c *ExtensionController) Delete(ctx context.Context, v Object) error {
-	_, err := c.delete(ctx, v, func(e extension.Extension, cu *protogen.SyncRequest) bool {
-		return e.Spec().Always || len(cu.Spec) > 0
+// RecordEvents records the events sent by extensions during the Sync
+// returning result. It's called once the changeset of result is applied, so
+// events aren't recorded for changes which were never made.
+func (c *ExtensionController) RecordEvents(ctx context.Context, v Object, result *Result) {
+	for _, r := range result.events {
+		c.events.record(ctx, v, r.extension, r.responses)
+	}
+}
+
+// syncs reports whether ext is run by Sync for the request.
+func syncs(ext extension.Extension, cu *protogen.SyncRequest) bool {
+	return ext.Spec().Always || len(cu.Spec) > 0
+}
+
+// SelectionChanged reports whether the extensions run by Sync for v differ
+// from applied, such as after the labels of v or its namespace changed.
+func (c *ExtensionController) SelectionChanged(ctx context.Context, v Object, applied []string) (bool, error) {
+	exts, err := c.manager.ExtensionsFor(ctx, v)
+	if err != nil {
+		return false, err
+	}
+
+	var kv extension.KeyValue
+	if err := json.Unmarshal(v.GetSpec(), &kv); err != nil {
+		return false, err
+	}
+
+	selected := []string{}
+	for _, ext := range exts {
+		ur, err := createOrUpdateRequest(v, kv, ext)
+		if err != nil {
+			return false, err
+		}
+		if syncs(ext, ur) {
+			selected = append(selected, ext.Name())
+		}
+	}
+
+	applied = slices.Clone(applied)
+	slices.Sort(applied)
+	slices.Sort(selected)
+	return !slices.Equal(applied, selected), nil
+}
+
+// Delete runs delete for the extensions applied to v, which may no longer
+// be enabled for it.
+func (c *ExtensionController) Delete(ctx context.Context, v Object, applied []string) error {
+	exts := c.manager.ExtensionsForKind(v.GetObjectKind().GroupVersionKind().Kind)
+	_, err := c.delete(ctx, v, exts, func(e extension.Extension, cu *protogen.SyncRequest) bool {
+		return slices.Contains(applied, e.Name()) && syncs(e, cu)
 	})
 	return err
 }
 
+// DeleteExtension runs delete for a single extension, which may no longer be
+// enabled for v.
 func (c *ExtensionController) DeleteExtension(ctx context.Context, v Object, extensionName string) error {
-	_, err := c.delete(ctx, v, func(e extension.Extension, cu *protogen.SyncRequest) bool {
+	exts := c.manager.ExtensionsForKind(v.GetObjectKind().GroupVersionKind().Kind)
+	_, err := c.delete(ctx, v, exts, func(e extension.Extension, cu *protogen.SyncRequest) bool {
 		return e.Name() == extensionName
 	})
 	return err
 }
 
-func (c *ExtensionController) delete(ctx context.Context, obj Object, runFunc shouldRunFunc) ([]*protogen.DeleteResponse, error) {
+func (c *ExtensionController) delete(ctx context.Context, obj Object, exts []extension.Extension, runFunc shouldRunFunc) ([]*protogen.DeleteResponse, error) {
 	ctx, span := tracing.Start(ctx, "extensions.Delete")
 	defer span.End()
 
@@ -131,21 +268,28 @@ func (c *ExtensionController) delete(ctx context.Context, obj Object, runFunc sh
 		return nil, err
 	}
 
-	exts := c.manager.ExtensionsFor(obj.GetObjectKind().GroupVersionKind().Kind)
 	for _, ext := range exts {
 		span.AddEvent("Delete " + ext.Name())
 		ext := ext
+		addErr := func(err error) {
+			if _, ok := ignoredFailure(ctx, "delete", ext, err); ok {
+				return
+			}
+			lock.Lock()
+			errs = append(errs, err)
+			lock.Unlock()
+		}
+
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-
 			start := time.Now()
+			defer c.recoverExtension(ctx, "delete", ext.Name(), start, addErr)
+
 			resp, err := c.runDelete(ctx, ext, obj, oldV, runFunc)
 			if err != nil {
-				c.metrics.WithLabelValues("delete", ext.Name(), "failure").Observe(time.Since(start).Seconds())
-				lock.Lock()
-				errs = append(errs, err)
-				lock.Unlock()
+				c.observeFailure("delete", ext.Name(), start, err)
+				addErr(err)
 				return
 			}
 			c.metrics.WithLabelValues("delete", ext.Name(), "success").Observe(time.Since(start).Seconds())
@@ -172,7 +316,14 @@ func (c *ExtensionController) runDelete(ctx context.Context, ext extension.Exten
 		return nil, nil
 	}
 
-	return ext.Delete(ctx, ur)
+	if err := checkHealth(ext); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := withTimeout(ctx, ext, "delete")
+	defer cancel()
+	resp, err := ext.Delete(ctx, ur)
+	return resp, timeoutError(ctx, ext, "delete", err)
 }
 
 func (c *ExtensionController) Default(ctx context.Context, obj Object) ([]*protogen.DefaultResponse, error) {
@@ -192,7 +343,10 @@ func (c *ExtensionController) Default(ctx context.Context, obj Object) ([]*proto
 		return nil, err
 	}
 
-	exts := c.manager.ExtensionsFor(obj.GetObjectKind().GroupVersionKind().Kind)
+	exts, err := c.manager.ExtensionsFor(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
 	for _, ext := range exts {
 		if !ext.Spec().Webhooks.Defaulting {
 			continue
@@ -200,17 +354,27 @@ func (c *ExtensionController) Default(ctx context.Context, obj Object) ([]*proto
 
 		span.AddEvent("Default " + ext.Name())
 		ext := ext
+		addErr := func(err error) {
+			// Defaulting webhooks are unable to return warnings, so ignored
+			// failures are only logged.
+			if _, ok := ignoredFailure(ctx, "default", ext, err); ok {
+				return
+			}
+			lock.Lock()
+			errs = append(errs, err)
+			lock.Unlock()
+		}
+
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-
 			start := time.Now()
+			defer c.recoverExtension(ctx, "default", ext.Name(), start, addErr)
+
 			resp, err := c.defaulter(ctx, ext, obj, v)
 			if err != nil {
-				c.metrics.WithLabelValues("default", ext.Name(), "failure").Observe(time.Since(start).Seconds())
-				lock.Lock()
-				errs = append(errs, err)
-				lock.Unlock()
+				c.observeFailure("default", ext.Name(), start, err)
+				addErr(err)
 				return
 			}
 			c.metrics.WithLabelValues("default", ext.Name(), "success").Observe(time.Since(start).Seconds())
@@ -235,18 +399,24 @@ func (c *ExtensionController) defaulter(ctx context.Context, ext extension.Exten
 		return nil, err
 	}
 
-	return ext.Default(ctx, req)
+	ctx, cancel := withTimeout(ctx, ext, "default")
+	defer cancel()
+	resp, err := ext.Default(ctx, req)
+	return resp, timeoutError(ctx, ext, "default", err)
 }
 
-func (c *ExtensionController) Validate(ctx context.Context, typ protogen.ValidationType, newObject, oldObject Object) error {
+// Validate asks the extensions for obj to validate it. Failures of extensions
+// with failurePolicy Ignore are returned as warnings.
+func (c *ExtensionController) Validate(ctx context.Context, typ protogen.ValidationType, newObject, oldObject Object) ([]string, error) {
 	ctx, span := tracing.Start(ctx, "extensions.Validate")
 	defer span.End()
 
 	var (
-		allErrs field.ErrorList
-		errs    MultiError
-		lock    sync.Mutex
-		wg      sync.WaitGroup
+		allErrs  field.ErrorList
+		errs     MultiError
+		warnings []string
+		lock     sync.Mutex
+		wg       sync.WaitGroup
 
 		newV, oldV extension.KeyValue
 		obj        Object
@@ -255,7 +425,7 @@ func (c *ExtensionController) Validate(ctx context.Context, typ protogen.Validat
 	if newObject != nil && len(newObject.GetSpec()) > 0 {
 		obj = newObject
 		if err := json.Unmarshal(newObject.GetSpec(), &newV); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if oldObject != nil && len(oldObject.GetSpec()) > 0 {
@@ -263,11 +433,18 @@ func (c *ExtensionController) Validate(ctx context.Context, typ protogen.Validat
 			obj = oldObject
 		}
 		if err := json.Unmarshal(oldObject.GetSpec(), &oldV); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	exts := c.manager.ExtensionsFor(obj.GetObjectKind().GroupVersionKind().Kind)
+	exts, err := c.manager.ExtensionsFor(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	if newObject != nil {
+		allErrs = append(allErrs, c.disabledFields(obj, exts, newV, oldV)...)
+	}
+
 	for _, ext := range exts {
 		if !ext.Spec().Webhooks.Validation {
 			continue
@@ -275,21 +452,34 @@ func (c *ExtensionController) Validate(ctx context.Context, typ protogen.Validat
 
 		span.AddEvent("Validate " + ext.Name())
 		ext := ext
+		addErr := func(err error) {
+			lock.Lock()
+			defer lock.Unlock()
+			if warning, ok := ignoredFailure(ctx, "validate", ext, err); ok {
+				warnings = append(warnings, warning)
+				return
+			}
+			errs = append(errs, err)
+		}
+
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-
 			start := time.Now()
-			if err := c.validate(ctx, typ, ext, newObject, oldObject, newV, oldV); err != nil {
+			defer c.recoverExtension(ctx, "validate", ext.Name(), start, addErr)
+
+			extWarnings, err := c.validate(ctx, typ, ext, newObject, oldObject, newV, oldV)
+			lock.Lock()
+			warnings = append(warnings, extWarnings...)
+			lock.Unlock()
+			if err != nil {
 				if ferr, ok := err.(FieldErrsWrapper); ok {
 					lock.Lock()
 					allErrs = append(allErrs, ferr...)
 					lock.Unlock()
 				} else {
-					c.metrics.WithLabelValues("validate", ext.Name(), "failure").Observe(time.Since(start).Seconds())
-					lock.Lock()
-					errs = append(errs, err)
-					lock.Unlock()
+					c.observeFailure("validate", ext.Name(), start, err)
+					addErr(err)
 					return
 				}
 			}
@@ -298,18 +488,45 @@ func (c *ExtensionController) Validate(ctx context.Context, typ protogen.Validat
 	}
 
 	wg.Wait()
+	slices.Sort(warnings)
 	if len(errs) > 0 {
-		return errs
+		return warnings, errs
 	}
 
 	if len(allErrs) == 0 {
-		return nil
+		return warnings, nil
+	}
+
+	return warnings, FieldErrsWrapper(allErrs)
+}
+
+// disabledFields returns an error for each field in newV which belongs to an
+// extension not enabled for obj. Fields left unchanged from oldV are allowed, so
+// objects are still updatable after an extension stops applying to them.
+func (c *ExtensionController) disabledFields(obj Object, enabled []extension.Extension, newV, oldV extension.KeyValue) field.ErrorList {
+	enabledKeys := map[string]bool{}
+	for _, ext := range enabled {
+		for _, key := range ext.RootKeys() {
+			enabledKeys[key] = true
+		}
 	}
 
-	return FieldErrsWrapper(allErrs)
+	var allErrs field.ErrorList
+	for _, ext := range c.manager.ExtensionsForKind(obj.GetObjectKind().GroupVersionKind().Kind) {
+		for _, key := range ext.RootKeys() {
+			val, ok := newV[key]
+			if !ok || enabledKeys[key] || reflect.DeepEqual(val, oldV[key]) {
+				continue
+			}
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", key), fmt.Sprintf("extension %q is not enabled for this object", ext.Name())))
+			enabledKeys[key] = true // report each field once
+		}
+	}
+	return allErrs
 }
 
-func (c *ExtensionController) validate(ctx context.Context, typ protogen.ValidationType, ext extension.Extension, newO, oldO Object, newV, oldV extension.KeyValue) error {
+// validate asks ext to validate the objects, returning the warnings of the extension.
+func (c *ExtensionController) validate(ctx context.Context, typ protogen.ValidationType, ext extension.Extension, newO, oldO Object, newV, oldV extension.KeyValue) ([]string, error) {
 	req := &protogen.ValidationRequest{
 		Type: typ,
 	}
@@ -317,25 +534,27 @@ func (c *ExtensionController) validate(ctx context.Context, typ protogen.Validat
 	if newO != nil {
 		ur, err := createOrUpdateRequest(newO, newV, ext)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		req.Sync = ur
 	}
 	if oldO != nil {
 		ur, err := createOrUpdateRequest(oldO, oldV, ext)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		req.Old = ur
 	}
 
 	if req.Sync == nil && req.Old == nil {
-		return nil
+		return nil, nil
 	}
 
+	ctx, cancel := withTimeout(ctx, ext, "validate")
+	defer cancel()
 	resp, err := ext.Validate(ctx, req)
 	if err != nil {
-		return err
+		return nil, timeoutError(ctx, ext, "validate", err)
 	}
 
 	var allErrs field.ErrorList
@@ -349,78 +568,199 @@ func (c *ExtensionController) validate(ctx context.Context, typ protogen.Validat
 			),
 		)
 	}
-	return FieldErrsWrapper(allErrs)
+	if len(allErrs) > 0 {
+		return resp.Warnings, FieldErrsWrapper(allErrs)
+	}
+	return resp.Warnings, nil
 }
 
+// run runs the extensions for o in stages, see extensionStages. Extensions in
+// the same stage run in parallel, and their responses are added to the
+// changeset in stage order once all of them are done. The outputs of earlier
+// stages are passed to later stages. No more stages are run after a stage
+// failed.
 func (c *ExtensionController) run(ctx context.Context, operation string, o Object, rf requestFunc, runFunc shouldRunFunc) (*Result, error) {
-	exts := c.manager.ExtensionsFor(o.GetObjectKind().GroupVersionKind().Kind)
+	exts, err := c.manager.ExtensionsFor(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	stages, err := extensionStages(exts)
+	if err != nil {
+		return nil, err
+	}
+
 	result := &Result{
 		Changeset: &extension.Changeset{},
 	}
-	errs := MultiError{}
-	wg := sync.WaitGroup{}
 
 	var v extension.KeyValue
 	if err := json.Unmarshal(o.GetSpec(), &v); err != nil {
 		return nil, err
 	}
 
-	for _, ext := range exts {
-		ext := ext
-
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			start := time.Now()
-			if err := c.runExtension(ctx, ext, o, v, result, rf, runFunc); err != nil {
-				c.metrics.WithLabelValues(operation, ext.Name(), "failure").Observe(time.Since(start).Seconds())
+	outputs := map[string]*protogen.ExtensionOutput{}
+	for _, stage := range stages {
+		errs := MultiError{}
+		lock := sync.Mutex{}
+		wg := sync.WaitGroup{}
+
+		responses := make([][]*protogen.Response, len(stage))
+		addErrs := make([]func(error), len(stage))
+		for i, ext := range stage {
+			i, ext := i, ext
+			addErr := func(err error) {
+				if warning, ok := ignoredFailure(ctx, operation, ext, err); ok {
+					result.Warnings.Add(warning)
+					result.Ignored.Add(ext.Name())
+					return
+				}
+				lock.Lock()
 				errs = append(errs, err)
-				return
+				lock.Unlock()
 			}
-			c.metrics.WithLabelValues(operation, ext.Name(), "success").Observe(time.Since(start).Seconds())
-		}()
-	}
+			addErrs[i] = addErr
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				start := time.Now()
+				defer c.recoverExtension(ctx, operation, ext.Name(), start, addErr)
+
+				resps, err := c.runExtension(ctx, operation, ext, o, v, outputs, result, rf, runFunc)
+				if err != nil {
+					c.observeFailure(operation, ext.Name(), start, err)
+					addErr(err)
+					return
+				}
+				responses[i] = resps
+				c.metrics.WithLabelValues(operation, ext.Name(), "success").Observe(time.Since(start).Seconds())
+			}()
+		}
+		wg.Wait()
 
-	wg.Wait()
-	if len(errs) > 0 {
-		return result, errs
+		for i, ext := range stage {
+			if !result.Extensions.Contains(ext.Name()) || result.Ignored.Contains(ext.Name()) {
+				continue
+			}
+			output, err := addResponses(result.Changeset, ext.Name(), responses[i])
+			if err != nil {
+				addErrs[i](fmt.Errorf("%s: %w", ext.Name(), err))
+				continue
+			}
+			outputs[ext.Name()] = output
+			result.events = append(result.events, extensionResponses{extension: ext.Name(), responses: responses[i]})
+		}
+
+		if len(errs) > 0 {
+			return result, errs
+		}
 	}
 	return result, nil
 }
 
-func (c *ExtensionController) runExtension(ctx context.Context, ext extension.Extension, o Object, v extension.KeyValue, result *Result, rf requestFunc, runFunc shouldRunFunc) (err error) {
-	changeset := result.Changeset
-
+// runExtension runs ext for o, returning the responses it sent.
+func (c *ExtensionController) runExtension(ctx context.Context, operation string, ext extension.Extension, o Object, v extension.KeyValue, outputs map[string]*protogen.ExtensionOutput, result *Result, rf requestFunc, runFunc shouldRunFunc) ([]*protogen.Response, error) {
 	ctx, span := tracing.Start(ctx, "runExtension")
 	defer span.End()
 	ur, err := createOrUpdateRequest(o, v, ext)
 	if err != nil {
-		return err
+		return nil, err
 	} else if !runFunc(ext, ur) {
-		return nil
+		return nil, nil
+	}
+	if len(outputs) > 0 {
+		ur.Outputs = outputs
 	}
 
 	result.Extensions.Add(ext.Name())
 
+	if err := checkHealth(ext); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	ctx, cancel := withTimeout(ctx, ext, operation)
+	defer cancel()
 	stream, err := rf(ctx, ext, ur)
 	if err != nil {
-		return err
+		return nil, timeoutError(ctx, ext, operation, err)
 	}
 
+	var responses []*protogen.Response
 	for {
 		resp, err := stream.Recv()
 		if err != nil {
 			if err == io.EOF {
-				return nil
+				return responses, nil
 			}
 			span.RecordError(err)
-			return err
+			return nil, timeoutError(ctx, ext, operation, err)
+		}
+		responses = append(responses, resp)
+	}
+}
+
+// addResponses adds the responses of the named extension to changeset, and
+// returns the output passed to extensions in later stages.
+func addResponses(changeset *extension.Changeset, name string, responses []*protogen.Response) (*protogen.ExtensionOutput, error) {
+	// Check the responses first, so a failure doesn't leave some of them in
+	// the changeset.
+	if _, err := collectResponses(&extension.Changeset{}, name, responses); err != nil {
+		return nil, err
+	}
+	return collectResponses(changeset, name, responses)
+}
+
+func collectResponses(changeset *extension.Changeset, name string, responses []*protogen.Response) (*protogen.ExtensionOutput, error) {
+	output := &protogen.ExtensionOutput{}
+	for _, resp := range responses {
+		switch r := resp.OFResponse.(type) {
+		case *protogen.Response_Status:
+			changeset.SetStatus(name, r.Status)
+			output.Status = r.Status
+			continue
+		case *protogen.Response_Env:
+			output.Env = append(output.Env, r.Env)
+		case *protogen.Response_Annotation:
+			if output.Annotations == nil {
+				output.Annotations = map[string]string{}
+			}
+			output.Annotations[r.Annotation.Name] = r.Annotation.Value
+		case *protogen.Response_Event:
+			// Events are recorded by the controller.
+			continue
 		}
 
 		if err := changeset.Add(resp); err != nil {
-			return err
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+// extensionStages groups exts into the stages they run in, see suffiksv1.ExtensionStages.
+func extensionStages(exts []extension.Extension) ([][]extension.Extension, error) {
+	byName := make(map[string]extension.Extension, len(exts))
+	specs := make(map[string]suffiksv1.ExtensionSpec, len(exts))
+	for _, ext := range exts {
+		byName[ext.Name()] = ext
+		specs[ext.Name()] = ext.Spec()
+	}
+
+	names, err := suffiksv1.ExtensionStages(specs)
+	if err != nil {
+		return nil, err
+	}
+
+	stages := make([][]extension.Extension, 0, len(names))
+	for _, stage := range names {
+		exts := make([]extension.Extension, 0, len(stage))
+		for _, name := range stage {
+			exts = append(exts, byName[name])
 		}
+		stages = append(stages, exts)
 	}
+	return stages, nil
 }
 
 // MultiError is a slice of errors implementing the error interface. It is used
@@ -441,6 +781,83 @@ func (errs MultiError) Error() string {
 	return buf.String()
 }
 
+// Unwrap returns the contained errors, allowing errors.Is and errors.As to inspect them.
+func (errs MultiError) Unwrap() []error {
+	return errs
+}
+
+// Unhealthy returns the names of the extensions in names used by o that are
+// currently unable to serve requests.
+func (c *ExtensionController) Unhealthy(o Object, names []string) []string {
+	var unhealthy []string
+	for _, ext := range c.manager.ExtensionsForKind(o.GetObjectKind().GroupVersionKind().Kind) {
+		if !slices.Contains(names, ext.Name()) {
+			continue
+		}
+		if err := checkHealth(ext); err != nil {
+			unhealthy = append(unhealthy, ext.Name())
+		}
+	}
+	return unhealthy
+}
+
+// ignoredFailure reports whether err, returned by ext during operation, should
+// be ignored due to the failure policy of the extension. Ignored failures are
+// logged, recorded on the span and described by the returned warning.
+func ignoredFailure(ctx context.Context, operation string, ext extension.Extension, err error) (string, bool) {
+	if ext.Spec().FailurePolicy != suffiksv1.FailurePolicyIgnore {
+		return "", false
+	}
+
+	warning := fmt.Sprintf("extension %q failed during %s and was ignored: %v", ext.Name(), operation, err)
+	logr.FromContext(ctx).Info("ignoring extension failure", "extension", ext.Name(), "operation", operation, "error", err.Error())
+	trace.SpanFromContext(ctx).AddEvent("ignored failure", trace.WithAttributes(
+		attribute.String("extension", ext.Name()),
+		attribute.String("error", err.Error()),
+	))
+	return warning, true
+}
+
+// withTimeout returns a context cancelled after the timeout ext has for
+// operation. Without a timeout, the context is only cancelled with ctx.
+func withTimeout(ctx context.Context, ext extension.Extension, operation string) (context.Context, context.CancelFunc) {
+	var timeout *metav1.Duration
+	if t := ext.Spec().Timeout; t != nil {
+		switch operation {
+		case "sync":
+			timeout = t.Sync
+		case "validate":
+			timeout = t.Validate
+		case "default":
+			timeout = t.Default
+		case "delete":
+			timeout = t.Delete
+		}
+	}
+
+	if timeout == nil || timeout.Duration <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout.Duration)
+}
+
+// timeoutError describes err as a timeout if ctx, as returned by withTimeout,
+// exceeded its deadline.
+func timeoutError(ctx context.Context, ext extension.Extension, operation string, err error) error {
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("extension %q timed out during %s: %w", ext.Name(), operation, err)
+	}
+	return err
+}
+
+// checkHealth returns extension.ErrUnavailable if the extension reports that it is unhealthy.
+func checkHealth(ext extension.Extension) error {
+	if hc, ok := ext.(extension.HealthChecker); ok && !hc.Healthy() {
+		return fmt.Errorf("%w: %s", extension.ErrUnavailable, ext.Name())
+	}
+	return nil
+}
+
 func createOrUpdateRequest(o Object, v extension.KeyValue, ext extension.Extension) (*protogen.SyncRequest, error) {
 	if v == nil {
 		return nil, nil
@@ -0,0 +1,508 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suffiks/suffiks/extension/protogen"
+	"github.com/suffiks/suffiks/internal/extension"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type mockManager []extension.Extension
+
+func (m mockManager) ExtensionsFor(context.Context, client.Object) ([]extension.Extension, error) {
+	return m, nil
+}
+func (m mockManager) ExtensionsForKind(string) []extension.Extension { return m }
+
+type mockExtension struct {
+	extension.Extension
+	name     string
+	rootKeys []string
+	spec     suffiksv1.ExtensionSpec
+	sync     func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error)
+	validate func(context.Context, *protogen.ValidationRequest) (*protogen.ValidationResponse, error)
+	def      func(context.Context, *protogen.SyncRequest) (*protogen.DefaultResponse, error)
+}
+
+func (m *mockExtension) Name() string       { return m.name }
+func (m *mockExtension) RootKeys() []string { return m.rootKeys }
+func (m *mockExtension) Spec() suffiksv1.ExtensionSpec {
+	spec := m.spec
+	spec.Always = true
+	return spec
+}
+
+func (m *mockExtension) Sync(ctx context.Context, req *protogen.SyncRequest) (extension.StreamResponse, error) {
+	return m.sync(ctx, req)
+}
+
+func (m *mockExtension) Validate(ctx context.Context, req *protogen.ValidationRequest) (*protogen.ValidationResponse, error) {
+	return m.validate(ctx, req)
+}
+
+func (m *mockExtension) Default(ctx context.Context, req *protogen.SyncRequest) (*protogen.DefaultResponse, error) {
+	return m.def(ctx, req)
+}
+
+type eofStream struct{}
+
+func (eofStream) Recv() (*protogen.Response, error) { return nil, io.EOF }
+
+type sliceStream []*protogen.Response
+
+func (s *sliceStream) Recv() (*protogen.Response, error) {
+	if len(*s) == 0 {
+		return nil, io.EOF
+	}
+	resp := (*s)[0]
+	*s = (*s)[1:]
+	return resp, nil
+}
+
+func TestExtensionController_SyncPanic(t *testing.T) {
+	mgr := mockManager{
+		&mockExtension{
+			name: "ok",
+			sync: func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+				return eofStream{}, nil
+			},
+		},
+		&mockExtension{
+			name: "panics",
+			sync: func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) { panic("boom") },
+		},
+	}
+
+	app := &suffiksv1.Application{
+		TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"},
+		Spec: suffiksv1.ApplicationSpec{
+			Image: "image",
+		},
+	}
+
+	ctrl := NewExtensionController(mgr)
+	_, err := ctrl.Sync(context.Background(), app)
+
+	var multi MultiError
+	if !errors.As(err, &multi) || len(multi) != 1 {
+		t.Fatalf("expected a MultiError with one error, got %v", err)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected PanicError, got %v", err)
+	}
+	if panicErr.Extension != "panics" || panicErr.Operation != "sync" || panicErr.Value != "boom" {
+		t.Errorf("unexpected panic error: %+v", panicErr)
+	}
+	if !ctrl.metrics.DeleteLabelValues("sync", "panics", "failure") {
+		t.Error("expected the panic to be observed as a failure")
+	}
+
+	if !setSyncedCondition(app, err) {
+		t.Fatal("expected condition to change")
+	}
+	cond := meta.FindStatusCondition(app.Status.Conditions, suffiksv1.ConditionSynced)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != suffiksv1.ReasonExtensionPanic {
+		t.Errorf("unexpected condition: %+v", cond)
+	}
+
+	if !setSyncedCondition(app, &PanicError{Extension: "panics", Operation: "sync", Value: "bang"}) {
+		t.Error("expected message changes to be reported")
+	}
+}
+
+func TestExtensionController_SyncStatus(t *testing.T) {
+	status := func(phase string) *protogen.Response {
+		return &protogen.Response{
+			OFResponse: &protogen.Response_Status{
+				Status: &protogen.ExtensionStatus{Phase: phase, Outputs: map[string]string{"url": "https://app.example.com"}},
+			},
+		}
+	}
+
+	mgr := mockManager{
+		&mockExtension{
+			name: "ingress",
+			sync: func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+				return &sliceStream{status("Pending"), status("Ready")}, nil
+			},
+		},
+		&mockExtension{
+			name: "silent",
+			sync: func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+				return eofStream{}, nil
+			},
+		},
+	}
+
+	app := &suffiksv1.Application{
+		TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"},
+		Spec: suffiksv1.ApplicationSpec{
+			Image: "image",
+		},
+	}
+
+	result, err := NewExtensionController(mgr).Sync(context.Background(), app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]suffiksv1.OwnerExtensionStatus{
+		"ingress": {Phase: "Ready", Outputs: map[string]string{"url": "https://app.example.com"}},
+	}
+	if diff := cmp.Diff(expected, result.Changeset.Statuses()); diff != "" {
+		t.Errorf("unexpected statuses (-want +got):\n%s", diff)
+	}
+}
+
+func TestExtensionController_SelectionChanged(t *testing.T) {
+	ctrl := NewExtensionController(mockManager{
+		&mockExtension{name: "ingress"},
+		&mockExtension{name: "service"},
+	})
+
+	app := &suffiksv1.Application{
+		TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"},
+		Spec: suffiksv1.ApplicationSpec{
+			Image: "image",
+		},
+	}
+
+	tests := map[string]struct {
+		applied []string
+		changed bool
+	}{
+		"unchanged": {
+			applied: []string{"service", "ingress"},
+		},
+		"deselected": {
+			applied: []string{"ingress", "service", "removed"},
+			changed: true,
+		},
+		"selected": {
+			applied: []string{"ingress"},
+			changed: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			changed, err := ctrl.SelectionChanged(context.Background(), app, tc.applied)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if changed != tc.changed {
+				t.Errorf("expected changed to be %v, got %v", tc.changed, changed)
+			}
+		})
+	}
+}
+
+func TestExtensionController_SyncStages(t *testing.T) {
+	env := func(name, value string) *protogen.Response {
+		return &protogen.Response{OFResponse: &protogen.Response_Env{Env: &protogen.KeyValue{Name: name, Value: value}}}
+	}
+	patch := func(p string) *protogen.Response {
+		return &protogen.Response{OFResponse: &protogen.Response_MergePatch{MergePatch: []byte(p)}}
+	}
+
+	var ingressOutputs map[string]*protogen.ExtensionOutput
+	mgr := mockManager{
+		&mockExtension{
+			name: "ingress",
+			spec: suffiksv1.ExtensionSpec{DependsOn: []string{"cert"}},
+			sync: func(_ context.Context, req *protogen.SyncRequest) (extension.StreamResponse, error) {
+				ingressOutputs = req.Outputs
+				return &sliceStream{patch(`{"spec":{"replicas":2}}`)}, nil
+			},
+		},
+		&mockExtension{
+			name: "cert",
+			sync: func(_ context.Context, req *protogen.SyncRequest) (extension.StreamResponse, error) {
+				if len(req.Outputs) > 0 {
+					t.Errorf("expected no outputs in the first stage, got %v", req.Outputs)
+				}
+				return &sliceStream{env("CERT_PATH", "/etc/cert"), patch(`{"spec":{"replicas":1}}`)}, nil
+			},
+		},
+	}
+
+	app := &suffiksv1.Application{
+		TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"},
+		Spec: suffiksv1.ApplicationSpec{
+			Image: "image",
+		},
+	}
+
+	for i := 0; i < 10; i++ {
+		result, err := NewExtensionController(mgr).Sync(context.Background(), app)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := ingressOutputs["cert"].GetEnv(); len(got) != 1 || got[0].Value != "/etc/cert" {
+			t.Fatalf("expected the env of cert to be passed to ingress, got %v", ingressOutputs)
+		}
+
+		depl := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "app"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app"}},
+			}}},
+		}
+		if err := result.Changeset.Apply(depl); err != nil {
+			t.Fatal(err)
+		}
+		if got := ptr.Deref(depl.Spec.Replicas, 0); got != 2 {
+			t.Fatalf("expected the patch of the later stage to win, got %d replicas", got)
+		}
+	}
+}
+
+func TestExtensionController_SyncCycle(t *testing.T) {
+	eof := func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+		return eofStream{}, nil
+	}
+	mgr := mockManager{
+		&mockExtension{name: "a", spec: suffiksv1.ExtensionSpec{DependsOn: []string{"b"}}, sync: eof},
+		&mockExtension{name: "b", spec: suffiksv1.ExtensionSpec{DependsOn: []string{"a"}}, sync: eof},
+	}
+
+	app := &suffiksv1.Application{TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"}}
+	if _, err := NewExtensionController(mgr).Sync(context.Background(), app); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestExtensionController_disabledFields(t *testing.T) {
+	ingress := &mockExtension{name: "ingress", rootKeys: []string{"ingresses"}}
+	mesh := &mockExtension{name: "mesh", rootKeys: []string{"mesh"}}
+	c := NewExtensionController(mockManager{ingress, mesh})
+
+	app := &suffiksv1.Application{TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"}}
+
+	tests := map[string]struct {
+		enabled []extension.Extension
+		newV    extension.KeyValue
+		oldV    extension.KeyValue
+		want    field.ErrorList
+	}{
+		"all enabled": {
+			enabled: []extension.Extension{ingress, mesh},
+			newV:    extension.KeyValue{"ingresses": []any{"a"}, "mesh": true},
+		},
+		"field of disabled extension": {
+			enabled: []extension.Extension{ingress},
+			newV:    extension.KeyValue{"ingresses": []any{"a"}, "mesh": true},
+			want: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "mesh"), `extension "mesh" is not enabled for this object`),
+			},
+		},
+		"unchanged field of disabled extension": {
+			enabled: []extension.Extension{ingress},
+			newV:    extension.KeyValue{"mesh": true},
+			oldV:    extension.KeyValue{"mesh": true},
+		},
+		"changed field of disabled extension": {
+			enabled: []extension.Extension{ingress},
+			newV:    extension.KeyValue{"mesh": false},
+			oldV:    extension.KeyValue{"mesh": true},
+			want: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "mesh"), `extension "mesh" is not enabled for this object`),
+			},
+		},
+		"field not set": {
+			enabled: []extension.Extension{ingress},
+			newV:    extension.KeyValue{"ingresses": []any{"a"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := c.disabledFields(app, tc.enabled, tc.newV, tc.oldV)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("disabledFields() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// blockingStream blocks until its context is done.
+type blockingStream struct{ ctx context.Context }
+
+func (s blockingStream) Recv() (*protogen.Response, error) {
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+func TestExtensionController_SyncFailurePolicy(t *testing.T) {
+	failing := func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+		return nil, errors.New("unreachable")
+	}
+	blocking := func(ctx context.Context, _ *protogen.SyncRequest) (extension.StreamResponse, error) {
+		return blockingStream{ctx}, nil
+	}
+	invalid := func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+		return &sliceStream{
+			{OFResponse: &protogen.Response_Env{Env: &protogen.KeyValue{Name: "DOCS_URL", Value: "https://docs"}}},
+			{OFResponse: &protogen.Response_EnvFrom{EnvFrom: &protogen.EnvFrom{Name: "docs", Type: protogen.EnvFromType(42)}}},
+		}, nil
+	}
+	timeout := &suffiksv1.ExtensionTimeouts{Sync: &metav1.Duration{Duration: 10 * time.Millisecond}}
+
+	tests := map[string]struct {
+		ext          *mockExtension
+		wantErr      string
+		wantWarnings []string
+	}{
+		"fail": {
+			ext:     &mockExtension{name: "docs", sync: failing},
+			wantErr: "unreachable",
+		},
+		"ignore": {
+			ext: &mockExtension{
+				name: "docs",
+				spec: suffiksv1.ExtensionSpec{FailurePolicy: suffiksv1.FailurePolicyIgnore},
+				sync: failing,
+			},
+			wantWarnings: []string{`extension "docs" failed during sync and was ignored: unreachable`},
+		},
+		"timeout": {
+			ext: &mockExtension{
+				name: "docs",
+				spec: suffiksv1.ExtensionSpec{Timeout: timeout},
+				sync: blocking,
+			},
+			wantErr: `extension "docs" timed out during sync: context deadline exceeded`,
+		},
+		"ignored timeout": {
+			ext: &mockExtension{
+				name: "docs",
+				spec: suffiksv1.ExtensionSpec{FailurePolicy: suffiksv1.FailurePolicyIgnore, Timeout: timeout},
+				sync: blocking,
+			},
+			wantWarnings: []string{`extension "docs" failed during sync and was ignored: extension "docs" timed out during sync: context deadline exceeded`},
+		},
+		"invalid response": {
+			ext:     &mockExtension{name: "docs", sync: invalid},
+			wantErr: `docs: unknown envfrom type: "42"`,
+		},
+		"ignored invalid response": {
+			ext: &mockExtension{
+				name: "docs",
+				spec: suffiksv1.ExtensionSpec{FailurePolicy: suffiksv1.FailurePolicyIgnore},
+				sync: invalid,
+			},
+			wantWarnings: []string{`extension "docs" failed during sync and was ignored: docs: unknown envfrom type: "42"`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ok := &mockExtension{
+				name: "ok",
+				sync: func(context.Context, *protogen.SyncRequest) (extension.StreamResponse, error) {
+					return eofStream{}, nil
+				},
+			}
+			app := &suffiksv1.Application{TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"}}
+
+			result, err := NewExtensionController(mockManager{ok, tc.ext}).Sync(context.Background(), app)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.wantWarnings, result.Warnings.Slice()); diff != "" {
+				t.Errorf("unexpected warnings (-want +got):\n%s", diff)
+			}
+			if !result.Extensions.Contains("docs") {
+				t.Error("expected the ignored extension to be reported as running")
+			}
+			if !result.Ignored.Contains("docs") {
+				t.Error("expected the extension to be reported as ignored")
+			}
+
+			depl := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}}}}}
+			if err := result.Changeset.Apply(depl); err != nil {
+				t.Fatal(err)
+			}
+			if env := depl.Spec.Template.Spec.Containers[0].Env; len(env) > 0 {
+				t.Errorf("expected no responses of the ignored extension to be applied, got env %v", env)
+			}
+		})
+	}
+}
+
+func TestExtensionController_ValidateWarnings(t *testing.T) {
+	failing := func(context.Context, *protogen.ValidationRequest) (*protogen.ValidationResponse, error) {
+		return nil, errors.New("unreachable")
+	}
+	invalid := func(context.Context, *protogen.ValidationRequest) (*protogen.ValidationResponse, error) {
+		return &protogen.ValidationResponse{Errors: []*protogen.ValidationError{{Path: "image", Detail: "not allowed"}}}, nil
+	}
+	ignore := suffiksv1.ExtensionSpec{FailurePolicy: suffiksv1.FailurePolicyIgnore, Webhooks: suffiksv1.ExtensionWebhooks{Validation: true}}
+	fail := suffiksv1.ExtensionSpec{Webhooks: suffiksv1.ExtensionWebhooks{Validation: true}}
+
+	tests := map[string]struct {
+		ext          *mockExtension
+		wantErr      bool
+		wantWarnings []string
+	}{
+		"fail": {
+			ext:     &mockExtension{name: "cost", spec: fail, validate: failing},
+			wantErr: true,
+		},
+		"ignore": {
+			ext:          &mockExtension{name: "cost", spec: ignore, validate: failing},
+			wantWarnings: []string{`extension "cost" failed during validate and was ignored: unreachable`},
+		},
+		"invalid with ignore": {
+			ext:     &mockExtension{name: "cost", spec: ignore, validate: invalid},
+			wantErr: true,
+		},
+		"warnings": {
+			ext: &mockExtension{name: "cost", spec: fail, validate: func(context.Context, *protogen.ValidationRequest) (*protogen.ValidationResponse, error) {
+				return &protogen.ValidationResponse{Warnings: []string{"replicas above 10 are expensive"}}, nil
+			}},
+			wantWarnings: []string{"replicas above 10 are expensive"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := &suffiksv1.Application{
+				TypeMeta: metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"},
+				Spec:     suffiksv1.ApplicationSpec{Image: "image"},
+			}
+
+			warnings, err := NewExtensionController(mockManager{tc.ext}).Validate(context.Background(), protogen.ValidationType_CREATE, app, nil)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantWarnings, warnings); diff != "" {
+				t.Errorf("unexpected warnings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
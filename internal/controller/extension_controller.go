@@ -3,7 +3,9 @@ package controller
 import (
 	"context"
 	goerrors "errors"
+	"fmt"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/suffiks/suffiks/internal/extension"
@@ -12,13 +14,17 @@ import (
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 type crdDefinition struct {
@@ -37,12 +43,33 @@ var crds = map[string]crdDefinition{
 	},
 }
 
+// printerColumns are the additional printer columns of the Application and Work CRDs.
+var printerColumns = []apiextv1.CustomResourceColumnDefinition{
+	{
+		Name:     "Ready",
+		Type:     "string",
+		JSONPath: `.status.conditions[?(@.type=="Ready")].status`,
+	},
+	{
+		Name:     "Reason",
+		Type:     "string",
+		JSONPath: `.status.conditions[?(@.type=="Ready")].reason`,
+	},
+	{
+		Name:     "Age",
+		Type:     "date",
+		JSONPath: ".metadata.creationTimestamp",
+	},
+}
+
 // ExtensionReconciler reconciles a Extension object
 type ExtensionReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	CRDManager *extension.ExtensionManager
 	KubeConfig *rest.Config
+	// HealthEvents triggers a reconcile of the extension when its health changes.
+	HealthEvents <-chan event.GenericEvent
 
 	clientSet apiclient.Interface
 }
@@ -51,6 +78,7 @@ type ExtensionReconciler struct {
 //+kubebuilder:rbac:groups=suffiks.com,resources=extensions/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=suffiks.com,resources=extensions/finalizers,verbs=update
 //+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -81,12 +109,21 @@ func (r *ExtensionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		if err := r.CRDManager.Add(*(ext.DeepCopy())); err != nil {
 			if goerrors.Is(err, &specgen.AlreadyDefinedError{}) {
 				log.Info("CRD already exists, skipping", "error", err)
+				setExtensionCondition(ext, suffiksv1.ExtensionConditionLoaded, v1.ConditionTrue, suffiksv1.ExtensionReasonLoaded, "Extension is loaded")
+				setExtensionCondition(ext, suffiksv1.ExtensionConditionSchemaMerged, v1.ConditionFalse, suffiksv1.ExtensionReasonSchemaConflict, err.Error())
 			} else {
 				log.Error(err, "unable to add extension manifest")
+				setExtensionCondition(ext, suffiksv1.ExtensionConditionLoaded, v1.ConditionFalse, suffiksv1.ExtensionReasonLoadFailed, err.Error())
+				if err := r.updateExtensionStatus(ctx, ext); err != nil {
+					log.Error(err, "unable to update Extension status")
+				}
 				// if fail to delete the external dependency here, return with error
 				// so that it can be retried
 				return ctrl.Result{}, err
 			}
+		} else {
+			setExtensionCondition(ext, suffiksv1.ExtensionConditionLoaded, v1.ConditionTrue, suffiksv1.ExtensionReasonLoaded, "Extension is loaded")
+			setExtensionCondition(ext, suffiksv1.ExtensionConditionSchemaMerged, v1.ConditionTrue, suffiksv1.ExtensionReasonSchemaMerged, "Extension schema is merged into its targets")
 		}
 
 		for name, def := range crds {
@@ -103,14 +140,41 @@ func (r *ExtensionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 				_, err = r.clientSet.ApiextensionsV1().CustomResourceDefinitions().Update(ctx, crd, v1.UpdateOptions{})
 				if err != nil {
 					log.Error(err, "unable to update CRD")
+					setExtensionCondition(ext, suffiksv1.ExtensionConditionSchemaMerged, v1.ConditionFalse, suffiksv1.ExtensionReasonCRDUpdateFailed, err.Error())
+					if err := r.updateExtensionStatus(ctx, ext); err != nil {
+						log.Error(err, "unable to update Extension status")
+					}
 					return ctrl.Result{RequeueAfter: 15 * time.Second}, err
 				}
 			}
 
 		}
 
-		ext.Status.Status = suffiksv1.ExtensionStatusApplied
-		if err := r.Status().Update(ctx, ext); err != nil {
+		if version := r.CRDManager.Version(ext.Name); ext.Status.Version != version {
+			if ext.Status.Version != "" {
+				log.Info("extension upgraded, resyncing owners", "from", ext.Status.Version, "to", version)
+				if err := r.resyncOwners(ctx, ext.Name); err != nil {
+					log.Error(err, "unable to resync owners")
+					return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+				}
+			}
+			ext.Status.Version = version
+		}
+
+		if !r.CRDManager.Healthy(ext.Name) {
+			setExtensionCondition(ext, suffiksv1.ExtensionConditionReachable, v1.ConditionFalse, suffiksv1.ExtensionReasonUnavailable, "Extension is not responding to health checks")
+		} else {
+			if meta.IsStatusConditionFalse(ext.Status.Conditions, suffiksv1.ExtensionConditionReachable) {
+				log.Info("extension is available again, resyncing owners")
+				if err := r.resyncOwners(ctx, ext.Name); err != nil {
+					log.Error(err, "unable to resync owners")
+					return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+				}
+			}
+			setExtensionCondition(ext, suffiksv1.ExtensionConditionReachable, v1.ConditionTrue, suffiksv1.ExtensionReasonReachable, "Extension is able to serve requests")
+		}
+
+		if err := r.updateExtensionStatus(ctx, ext); err != nil {
 			log.Error(err, "unable to update Extension status")
 			return ctrl.Result{RequeueAfter: 5 * time.Second}, err
 		}
@@ -139,6 +203,89 @@ func (r *ExtensionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	return ctrl.Result{}, nil
 }
 
+// updateExtensionStatus sets the Ready condition and status text from the
+// other conditions of the extension and updates its status.
+func (r *ExtensionReconciler) updateExtensionStatus(ctx context.Context, ext *suffiksv1.Extension) error {
+	ready := v1.Condition{
+		Type:    suffiksv1.ExtensionConditionReady,
+		Status:  v1.ConditionTrue,
+		Reason:  suffiksv1.ExtensionReasonReady,
+		Message: "Extension is ready",
+	}
+	for _, typ := range []string{
+		suffiksv1.ExtensionConditionLoaded,
+		suffiksv1.ExtensionConditionSchemaMerged,
+		suffiksv1.ExtensionConditionReachable,
+	} {
+		cond := meta.FindStatusCondition(ext.Status.Conditions, typ)
+		if cond != nil && cond.Status != v1.ConditionTrue {
+			ready.Status = cond.Status
+			ready.Reason = cond.Reason
+			ready.Message = cond.Message
+			break
+		}
+	}
+	setExtensionCondition(ext, ready.Type, ready.Status, ready.Reason, ready.Message)
+
+	ext.Status.Status = suffiksv1.ExtensionStatusApplied
+	if meta.IsStatusConditionFalse(ext.Status.Conditions, suffiksv1.ExtensionConditionLoaded) {
+		ext.Status.Status = suffiksv1.ExtensionStatusInvalid
+	}
+
+	return r.Status().Update(ctx, ext)
+}
+
+func setExtensionCondition(ext *suffiksv1.Extension, typ string, status v1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&ext.Status.Conditions, v1.Condition{
+		Type:               typ,
+		Status:             status,
+		Reason:             reason,
+		Message:            truncateMessage(message),
+		ObservedGeneration: ext.Generation,
+	})
+}
+
+// resyncOwners clears the status hash of every Application and Work using the
+// extension. This forces a full sync with the new version of the extension.
+// The hash is cleared using a merge patch, so concurrent status updates don't
+// conflict, and every owner is tried before the errors are returned.
+func (r *ExtensionReconciler) resyncOwners(ctx context.Context, name string) error {
+	var errs []error
+
+	apps := &suffiksv1.ApplicationList{}
+	if err := r.List(ctx, apps); err != nil {
+		errs = append(errs, fmt.Errorf("resyncOwners list applications: %w", err))
+	}
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		if !slices.Contains(app.Status.Extensions, name) {
+			continue
+		}
+		patch := client.MergeFrom(app.DeepCopy())
+		app.Status.Hash = ""
+		if err := r.Status().Patch(ctx, app, patch); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("resyncOwners patch application %s/%s: %w", app.Namespace, app.Name, err))
+		}
+	}
+
+	works := &suffiksv1.WorkList{}
+	if err := r.List(ctx, works); err != nil {
+		errs = append(errs, fmt.Errorf("resyncOwners list works: %w", err))
+	}
+	for i := range works.Items {
+		work := &works.Items[i]
+		if !slices.Contains(work.Status.Extensions, name) {
+			continue
+		}
+		patch := client.MergeFrom(work.DeepCopy())
+		work.Status.Hash = ""
+		if err := r.Status().Patch(ctx, work, patch); client.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("resyncOwners patch work %s/%s: %w", work.Namespace, work.Name, err))
+		}
+	}
+	return goerrors.Join(errs...)
+}
+
 func (r *ExtensionReconciler) RefreshCRD(ctx context.Context) error {
 	client, err := client.New(r.KubeConfig, client.Options{Scheme: r.Scheme})
 	if err != nil {
@@ -173,6 +320,7 @@ func (r *ExtensionReconciler) RefreshCRD(ctx context.Context) error {
 
 		// It exists, we can update it
 		crd.Spec.Versions[0].Schema.OpenAPIV3Schema = r.CRDManager.Schema(def.Kind)
+		crd.Spec.Versions[0].AdditionalPrinterColumns = printerColumns
 		_, err = r.clientSet.ApiextensionsV1().CustomResourceDefinitions().Update(ctx, crd, v1.UpdateOptions{})
 		if err != nil {
 			return err
@@ -184,9 +332,14 @@ func (r *ExtensionReconciler) RefreshCRD(ctx context.Context) error {
 // SetupWithManager sets up the controller with the Manager.
 func (r *ExtensionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.clientSet = apiclient.NewForConfigOrDie(r.KubeConfig)
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&suffiksv1.Extension{}).
-		Complete(r)
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(&suffiksv1.Extension{})
+
+	if r.HealthEvents != nil {
+		bldr = bldr.WatchesRawSource(&source.Channel{Source: r.HealthEvents}, &handler.EnqueueRequestForObject{})
+	}
+
+	return bldr.Complete(r)
 }
 
 func createAppCRD(name string, schema *apiextv1.JSONSchemaProps) *apiextv1.CustomResourceDefinition {
@@ -215,6 +368,7 @@ func createAppCRD(name string, schema *apiextv1.JSONSchemaProps) *apiextv1.Custo
 					Subresources: &apiextv1.CustomResourceSubresources{
 						Status: &apiextv1.CustomResourceSubresourceStatus{},
 					},
+					AdditionalPrinterColumns: printerColumns,
 					Schema: &apiextv1.CustomResourceValidation{
 						OpenAPIV3Schema: schema,
 					},
@@ -237,9 +391,6 @@ func createWorkCRD(name string, schema *apiextv1.JSONSchemaProps) *apiextv1.Cust
 				ListKind: "WorkList",
 				Singular: "work",
 				Plural:   "works",
-				ShortNames: []string{
-					"nj",
-				},
 			},
 			Versions: []apiextv1.CustomResourceDefinitionVersion{
 				{
@@ -249,6 +400,7 @@ func createWorkCRD(name string, schema *apiextv1.JSONSchemaProps) *apiextv1.Cust
 					Subresources: &apiextv1.CustomResourceSubresources{
 						Status: &apiextv1.CustomResourceSubresourceStatus{},
 					},
+					AdditionalPrinterColumns: printerColumns,
 					Schema: &apiextv1.CustomResourceValidation{
 						OpenAPIV3Schema: schema,
 					},
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestExtensionReconciler_resyncOwners(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := suffiksv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	app := func(name string, extensions ...string) *suffiksv1.Application {
+		return &suffiksv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     suffiksv1.ApplicationStatus{Hash: "hash", Extensions: extensions},
+		}
+	}
+	using, other := app("using", "ingress"), app("other", "service")
+	work := &suffiksv1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: "work", Namespace: "default"},
+		Status:     suffiksv1.WorkStatus{Hash: "hash", Extensions: []string{"ingress"}},
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(using, other, work).
+		WithStatusSubresource(&suffiksv1.Application{}, &suffiksv1.Work{}).
+		Build()
+	r := &ExtensionReconciler{Client: c}
+
+	ctx := context.Background()
+	if err := r.resyncOwners(ctx, "ingress"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		obj  client.Object
+		hash func(client.Object) string
+		want string
+	}{
+		"application using the extension": {
+			obj:  &suffiksv1.Application{ObjectMeta: using.ObjectMeta},
+			hash: func(o client.Object) string { return o.(*suffiksv1.Application).Status.Hash },
+		},
+		"application not using the extension": {
+			obj:  &suffiksv1.Application{ObjectMeta: other.ObjectMeta},
+			hash: func(o client.Object) string { return o.(*suffiksv1.Application).Status.Hash },
+			want: "hash",
+		},
+		"work using the extension": {
+			obj:  &suffiksv1.Work{ObjectMeta: work.ObjectMeta},
+			hash: func(o client.Object) string { return o.(*suffiksv1.Work).Status.Hash },
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := c.Get(ctx, client.ObjectKeyFromObject(tc.obj), tc.obj); err != nil {
+				t.Fatal(err)
+			}
+			if got := tc.hash(tc.obj); got != tc.want {
+				t.Errorf("expected hash %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
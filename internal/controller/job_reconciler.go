@@ -1,196 +1,362 @@
 package controller
 
-// import (
-// 	"context"
-// 	"fmt"
-
-// 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
-// 	"github.com/suffiks/suffiks/base"
-// 	"github.com/suffiks/suffiks/base/tracing"
-// 	"go.opentelemetry.io/otel/trace"
-// 	batchv1 "k8s.io/api/batch/v1"
-// 	corev1 "k8s.io/api/core/v1"
-// 	"k8s.io/apimachinery/pkg/api/errors"
-// 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-// 	"k8s.io/apimachinery/pkg/runtime"
-// 	"sigs.k8s.io/controller-runtime/pkg/client"
-// 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-// )
-
-// var _ = &ReconcilerWrapper[*base.Work]{
-// 	Child: &JobReconciler{},
-// }
-
-// // When changing the lines below, run make
-// //+kubebuilder:rbac:groups=suffiks.com,resources=works,verbs=get;list;watch;create;update;patch;delete
-// //+kubebuilder:rbac:groups=suffiks.com,resources=works/status,verbs=get;update;patch
-// //+kubebuilder:rbac:groups=suffiks.com,resources=works/finalizers,verbs=update
-// //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
-// //+kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
-
-// // ValidationWebhook
-// //+kubebuilder:webhook:path=/validate-suffiks-com-v1-work,mutating=false,failurePolicy=fail,sideEffects=None,groups=suffiks.com,resources=works,verbs=create;update;delete,versions=v1,name=vwork.kb.io,admissionReviewVersions=v1
-// // DefaultingWebhook
-// //+kubebuilder:webhook:path=/mutate-suffiks-com-v1-work,mutating=true,failurePolicy=fail,sideEffects=None,groups=suffiks.com,resources=works,verbs=create;update,versions=v1,name=mwork.kb.io,admissionReviewVersions=v1
-
-// // JobReconciler reconciles a Work object
-// type JobReconciler struct {
-// 	Client client.Client
-// 	Scheme *runtime.Scheme
-
-// 	// KubeConfig    *rest.Config
-// 	// CRDController *base.ExtensionController
-
-// 	// klient kubernetes.Interface
-// 	// rest   *rest.RESTClient
-// }
-
-// func (j *JobReconciler) NewObject() *base.Work { return &base.Work{} }
-// func (j *JobReconciler) Owns() []client.Object { return nil }
-
-// func (j *JobReconciler) CreateOrUpdate(ctx context.Context, job *base.Work, changeset *base.Changeset) error {
-// 	ctx, span := tracing.Start(ctx, "AppReconciler.CreateOrUpdate")
-// 	defer span.End()
-
-// 	spec, err := job.WellKnownSpec()
-// 	if err != nil {
-// 		return fmt.Errorf("CreateOrUpdate: %w", err)
-// 	}
-
-// 	if spec.Schedule == "" {
-// 		err = j.createOrUpdateJob(ctx, job, spec, changeset)
-// 	} else {
-// 		err = j.createOrUpdateCronJob(ctx, job, spec, changeset)
-// 	}
-
-// 	return err
-// }
-
-// func (j *JobReconciler) Delete(ctx context.Context, job *base.Work) error {
-// 	return j.Client.Delete(ctx, &batchv1.Job{
-// 		ObjectMeta: metav1.ObjectMeta{
-// 			Name:      job.Name,
-// 			Namespace: job.Namespace,
-// 		},
-// 	})
-// }
-
-// func (j *JobReconciler) createOrUpdateJob(ctx context.Context, job *base.Work, spec suffiksv1.WorkSpec, changeset *base.Changeset) error {
-// 	jb := &batchv1.Job{
-// 		ObjectMeta: metav1.ObjectMeta{
-// 			Name:      job.Name,
-// 			Namespace: job.Namespace,
-// 			Labels:    job.Labels,
-// 		},
-// 		Spec: j.jobSpec(job, spec, changeset),
-// 	}
-
-// 	if err := controllerutil.SetControllerReference(job, jb, j.Scheme); err != nil {
-// 		trace.SpanFromContext(ctx).RecordError(err)
-// 		return fmt.Errorf("unable to set controller reference: %w", err)
-// 	}
-
-// 	if err := j.Client.Create(ctx, jb); err != nil {
-// 		if !errors.IsAlreadyExists(err) {
-// 			// If it already exists, then we don't need to do anything.
-// 			return fmt.Errorf("Reconcile create: %w", err)
-// 		}
-// 	}
-// 	return nil
-// }
-
-// func (j *JobReconciler) createOrUpdateCronJob(ctx context.Context, job *base.Work, spec suffiksv1.WorkSpec, changeset *base.Changeset) error {
-// 	jb := &batchv1.CronJob{
-// 		ObjectMeta: metav1.ObjectMeta{
-// 			Name:      job.Name,
-// 			Namespace: job.Namespace,
-// 			Labels:    job.Labels,
-// 		},
-// 		Spec: batchv1.CronJobSpec{
-// 			Schedule: spec.Schedule,
-// 			JobTemplate: batchv1.JobTemplateSpec{
-// 				ObjectMeta: metav1.ObjectMeta{
-// 					Labels: job.Labels,
-// 				},
-// 				Spec: j.jobSpec(job, spec, changeset),
-// 			},
-// 		},
-// 	}
-
-// 	if err := controllerutil.SetControllerReference(job, jb, j.Scheme); err != nil {
-// 		trace.SpanFromContext(ctx).RecordError(err)
-// 		return fmt.Errorf("unable to set controller reference: %w", err)
-// 	}
-
-// 	if err := j.Client.Create(ctx, jb); err != nil {
-// 		if errors.IsAlreadyExists(err) {
-// 			if err := j.Client.Update(ctx, jb); err != nil {
-// 				return fmt.Errorf("Reconcile update: %w", err)
-// 			}
-// 		} else {
-// 			return fmt.Errorf("Reconcile create: %w", err)
-// 		}
-// 	}
-// 	return nil
-// }
-
-// func (a *JobReconciler) UpdateStatus(ctx context.Context, job *base.Work, extensions []string) error {
-// 	hash, err := job.Hash()
-// 	if err != nil {
-// 		return fmt.Errorf("error hashing application: %w", err)
-// 	}
-// 	job.Status.Extensions = extensions
-// 	job.Status.Hash = hash
-// 	return nil
-// }
-
-// func (j *JobReconciler) IsModified(ctx context.Context, job *base.Work) (bool, error) {
-// 	h, err := job.Hash()
-// 	if err != nil {
-// 		return false, err
-// 	}
-
-// 	if job.Status.Hash == h {
-// 		// ok := client.ObjectKeyFromObject(job)
-// 		// if err := j.Client.Get(ctx, ok, &appsv1.Deployment{}); err != nil && errors.IsNotFound(err) {
-// 		// 	return true, nil
-// 		// } else if err != nil {
-// 		// 	return false, err
-// 		// }
-// 		return false, nil
-// 	}
-// 	return true, nil
-// }
-
-// func (j *JobReconciler) Extensions(job *base.Work) []string {
-// 	return job.Status.Extensions
-// }
-
-// func (r *JobReconciler) jobSpec(job *base.Work, spec suffiksv1.WorkSpec, changeset *base.Changeset) batchv1.JobSpec {
-// 	container := r.newContainer(job, spec, changeset)
-
-// 	return batchv1.JobSpec{
-// 		Template: corev1.PodTemplateSpec{
-// 			ObjectMeta: metav1.ObjectMeta{
-// 				Labels: job.Labels,
-// 			},
-// 			Spec: corev1.PodSpec{
-// 				RestartPolicy: corev1.RestartPolicy(spec.RestartPolicy),
-// 				Containers: []corev1.Container{
-// 					container,
-// 				},
-// 			},
-// 		},
-// 	}
-// }
-
-// func (r *JobReconciler) newContainer(work *base.Work, spec suffiksv1.WorkSpec, changeset *base.Changeset) corev1.Container {
-// 	return corev1.Container{
-// 		Name:            work.Name,
-// 		Image:           spec.Image,
-// 		Command:         spec.Command,
-// 		ImagePullPolicy: corev1.PullIfNotPresent,
-// 		Env:             changeset.Environment,
-// 		EnvFrom:         changeset.EnvFrom,
-// 	}
-// }
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mitchellh/hashstructure/v2"
+	"github.com/suffiks/suffiks/internal/extension"
+	"github.com/suffiks/suffiks/internal/tracing"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	"go.opentelemetry.io/otel/attribute"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var (
+	_ Reconciler[*suffiksv1.Work]         = &JobReconciler{}
+	_ ReconcilerDefault[*suffiksv1.Work]  = &JobReconciler{}
+	_ ReconcilerWorkload[*suffiksv1.Work] = &JobReconciler{}
+)
+
+// When changing the lines below, run make
+//+kubebuilder:rbac:groups=suffiks.com,resources=works,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=suffiks.com,resources=works/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=suffiks.com,resources=works/finalizers,verbs=update
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// ValidationWebhook
+//+kubebuilder:webhook:path=/validate-suffiks-com-v1-work,mutating=false,failurePolicy=fail,sideEffects=None,groups=suffiks.com,resources=works,verbs=create;update;delete,versions=v1,name=vwork.kb.io,admissionReviewVersions=v1
+// DefaultingWebhook
+//+kubebuilder:webhook:path=/mutate-suffiks-com-v1-work,mutating=true,failurePolicy=fail,sideEffects=None,groups=suffiks.com,resources=works,verbs=create;update,versions=v1,name=mwork.kb.io,admissionReviewVersions=v1
+
+const (
+	// jobTemplateHashAnnotation is set on Jobs to the hash of their pod template.
+	// The pod template of a Job is immutable, so the Job is replaced when it changes.
+	jobTemplateHashAnnotation = "suffiks.com/template-hash"
+	// jobReplaceRequeueAfter is how long to wait for a replaced Job to be deleted.
+	jobReplaceRequeueAfter = 5 * time.Second
+)
+
+// JobReconciler reconciles a Work object.
+// A Work without a schedule results in a Job, while a scheduled Work results in a CronJob.
+type JobReconciler struct {
+	Scheme *runtime.Scheme
+	Client client.Client
+}
+
+func (j *JobReconciler) NewObject() *suffiksv1.Work { return &suffiksv1.Work{} }
+
+func (j *JobReconciler) CreateOrUpdate(ctx context.Context, work *suffiksv1.Work, changeset *extension.Changeset) error {
+	ctx, span := tracing.Start(ctx, "JobReconciler.CreateOrUpdate")
+	defer span.End()
+
+	spec, err := work.WellKnownSpec()
+	if err != nil {
+		return err
+	}
+
+	if spec.Schedule == "" {
+		err = j.createOrUpdateJob(ctx, work, spec, changeset)
+	} else {
+		err = j.createOrUpdateCronJob(ctx, work, spec, changeset)
+	}
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}
+
+func (j *JobReconciler) createOrUpdateJob(ctx context.Context, work *suffiksv1.Work, spec suffiksv1.WorkSpec, changeset *extension.Changeset) error {
+	span := tracing.Get(ctx)
+
+	if err := j.deleteIfExists(ctx, &batchv1.CronJob{ObjectMeta: j.objectMeta(work)}); err != nil {
+		return fmt.Errorf("Reconcile delete cronjob: %w", err)
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: j.objectMeta(work),
+		Spec:       j.jobSpec(work, spec),
+	}
+	if err := controllerutil.SetControllerReference(work, job, j.Scheme); err != nil {
+		return fmt.Errorf("unable to set controller reference: %w", err)
+	}
+
+	if err := changeset.Apply(job); err != nil {
+		return fmt.Errorf("unable to modify Job: %w", err)
+	}
+	job.Spec.Template.Annotations = mergeMaps(job.Spec.Template.Annotations, job.Annotations)
+
+	hash, err := work.Hash()
+	if err != nil {
+		return fmt.Errorf("error hashing work: %w", err)
+	}
+	templateHash, err := hashstructure.Hash(job.Spec.Template, hashstructure.FormatV2, nil)
+	if err != nil {
+		return fmt.Errorf("error hashing pod template: %w", err)
+	}
+	job.Annotations = mergeMaps(job.Annotations, map[string]string{
+		hashAnnotation:            hash,
+		jobTemplateHashAnnotation: strconv.FormatUint(templateHash, 10),
+	})
+
+	existing := &batchv1.Job{}
+	err = j.Client.Get(ctx, client.ObjectKeyFromObject(job), existing)
+	switch {
+	case errors.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("error getting job: %w", err)
+	case existing.DeletionTimestamp != nil:
+		return &RequeueError{After: jobReplaceRequeueAfter, Reason: "waiting for the replaced Job to be deleted"}
+	case existing.Annotations[jobTemplateHashAnnotation] == job.Annotations[jobTemplateHashAnnotation]:
+		span.SetAttributes(attribute.String("action", "keep job"))
+		return nil
+	case jobCompleted(existing):
+		// Replacing a completed Job would run the work again.
+		span.SetAttributes(attribute.String("action", "keep completed job"))
+		return nil
+	default:
+		// The pod template of a Job is immutable, so the Job has to be replaced.
+		span.SetAttributes(attribute.String("action", "replace job"))
+		if err := j.deleteIgnoreNotFound(ctx, existing); err != nil {
+			return fmt.Errorf("Reconcile delete job: %w", err)
+		}
+	}
+
+	span.SetAttributes(attribute.String("action", "create job"))
+	if err := j.Client.Create(ctx, job); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return &RequeueError{After: jobReplaceRequeueAfter, Reason: "waiting for the replaced Job to be deleted"}
+		}
+		return fmt.Errorf("Reconcile create job: %w", err)
+	}
+	return nil
+}
+
+func (j *JobReconciler) createOrUpdateCronJob(ctx context.Context, work *suffiksv1.Work, spec suffiksv1.WorkSpec, changeset *extension.Changeset) error {
+	span := tracing.Get(ctx)
+
+	if err := j.deleteIfExists(ctx, &batchv1.Job{ObjectMeta: j.objectMeta(work)}); err != nil {
+		return fmt.Errorf("Reconcile delete job: %w", err)
+	}
+
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: j.objectMeta(work),
+		Spec: batchv1.CronJobSpec{
+			Schedule: spec.Schedule,
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: j.jobSpec(work, spec),
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(work, cronJob, j.Scheme); err != nil {
+		return fmt.Errorf("unable to set controller reference: %w", err)
+	}
+
+	if err := changeset.Apply(cronJob); err != nil {
+		return fmt.Errorf("unable to modify CronJob: %w", err)
+	}
+	cronJob.Spec.JobTemplate.Spec.Template.Annotations = mergeMaps(cronJob.Spec.JobTemplate.Spec.Template.Annotations, cronJob.Annotations)
+
+	hash, err := work.Hash()
+	if err != nil {
+		return fmt.Errorf("error hashing work: %w", err)
+	}
+	cronJob.Annotations = mergeMaps(cronJob.Annotations, map[string]string{hashAnnotation: hash})
+
+	span.SetAttributes(attribute.String("action", "apply cronjob"))
+	if err := serverSideApply(ctx, j.Client, j.Scheme, cronJob); err != nil {
+		return fmt.Errorf("Reconcile apply cronjob: %w", err)
+	}
+	return nil
+}
+
+func (j *JobReconciler) UpdateStatus(ctx context.Context, work *suffiksv1.Work, extensions []string, reported map[string]suffiksv1.OwnerExtensionStatus) (updates bool, err error) {
+	hash, err := work.Hash()
+	if err != nil {
+		return updates, fmt.Errorf("error hashing work: %w", err)
+	}
+	if work.Status.Hash != hash {
+		updates = true
+		work.Status.Hash = hash
+	}
+
+	if !slices.Equal(work.Status.Extensions, extensions) {
+		updates = true
+		work.Status.Extensions = extensions
+	}
+
+	statuses := extensionStatuses(work.Status.ExtensionStatuses, reported, extensions)
+	if !equality.Semantic.DeepEqual(work.Status.ExtensionStatuses, statuses) {
+		updates = true
+		work.Status.ExtensionStatuses = statuses
+	}
+	return updates, nil
+}
+
+// WorkloadStatus reports a Job as ready when it has succeeded, and a CronJob
+// as ready as soon as it exists. Both are progressing while they have active pods.
+func (j *JobReconciler) WorkloadStatus(ctx context.Context, work *suffiksv1.Work) (WorkloadStatus, error) {
+	key := client.ObjectKeyFromObject(work)
+	if work.Spec.Schedule != "" {
+		cron := &batchv1.CronJob{}
+		if err := j.Client.Get(ctx, key, cron); err != nil {
+			if errors.IsNotFound(err) {
+				return WorkloadStatus{Progressing: true, Message: "CronJob is not created yet"}, nil
+			}
+			return WorkloadStatus{}, fmt.Errorf("WorkloadStatus: get cronjob: %w", err)
+		}
+
+		return WorkloadStatus{
+			Ready:       true,
+			Progressing: len(cron.Status.Active) > 0,
+			Message:     fmt.Sprintf("%d active jobs", len(cron.Status.Active)),
+		}, nil
+	}
+
+	job := &batchv1.Job{}
+	if err := j.Client.Get(ctx, key, job); err != nil {
+		if errors.IsNotFound(err) {
+			return WorkloadStatus{Progressing: true, Message: "Job is not created yet"}, nil
+		}
+		return WorkloadStatus{}, fmt.Errorf("WorkloadStatus: get job: %w", err)
+	}
+
+	status := WorkloadStatus{
+		Ready:       job.Status.Succeeded > 0,
+		Progressing: job.Status.Active > 0,
+		Message:     "Job is running",
+	}
+	switch {
+	case status.Ready:
+		status.Message = "Job succeeded"
+	case job.Status.Failed > 0 && !status.Progressing:
+		status.Message = "Job failed"
+	}
+	return status, nil
+}
+
+func (j *JobReconciler) IsModified(ctx context.Context, work *suffiksv1.Work) (bool, error) {
+	h, err := work.Hash()
+	if err != nil {
+		tracing.Get(ctx).RecordError(fmt.Errorf("IsModified: get work hash: %w", err))
+		return false, err
+	}
+
+	if work.Status.Hash != h {
+		tracing.Get(ctx).AddEvent("Hash mismatch")
+		return true, nil
+	}
+
+	var obj client.Object = &batchv1.Job{}
+	if work.Spec.Schedule != "" {
+		obj = &batchv1.CronJob{}
+	}
+
+	if err := j.Client.Get(ctx, client.ObjectKeyFromObject(work), obj); err != nil && errors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func (j *JobReconciler) Delete(ctx context.Context, work *suffiksv1.Work) error {
+	if err := j.deleteIgnoreNotFound(ctx, &batchv1.Job{ObjectMeta: j.objectMeta(work)}); err != nil {
+		return err
+	}
+	return j.deleteIgnoreNotFound(ctx, &batchv1.CronJob{ObjectMeta: j.objectMeta(work)})
+}
+
+func (j *JobReconciler) Extensions(work *suffiksv1.Work) []string {
+	return work.Status.Extensions
+}
+
+func (j *JobReconciler) Owns() []client.Object {
+	return []client.Object{
+		&batchv1.Job{},
+		&batchv1.CronJob{},
+	}
+}
+
+func (j *JobReconciler) Default(ctx context.Context, work *suffiksv1.Work) error {
+	if work.Spec.RestartPolicy == "" {
+		tracing.Get(ctx).AddEvent("add_default_restart_policy")
+		work.Spec.RestartPolicy = string(corev1.RestartPolicyNever)
+	}
+	return nil
+}
+
+// deleteIfExists deletes obj if it exists. The object is looked up first,
+// which is served by the cache, to not send a delete on every reconcile.
+func (j *JobReconciler) deleteIfExists(ctx context.Context, obj client.Object) error {
+	if err := j.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return j.deleteIgnoreNotFound(ctx, obj)
+}
+
+func (j *JobReconciler) deleteIgnoreNotFound(ctx context.Context, obj client.Object) error {
+	err := j.Client.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (j *JobReconciler) jobSpec(work *suffiksv1.Work, spec suffiksv1.WorkSpec) batchv1.JobSpec {
+	labels := map[string]string{
+		"app.kubernetes.io/name": work.Name,
+	}
+
+	restartPolicy := corev1.RestartPolicy(spec.RestartPolicy)
+	if restartPolicy == "" {
+		restartPolicy = corev1.RestartPolicyNever
+	}
+
+	return batchv1.JobSpec{
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: labels,
+			},
+			Spec: corev1.PodSpec{
+				RestartPolicy: restartPolicy,
+				Containers: []corev1.Container{
+					{
+						Name:    work.Name,
+						Image:   spec.Image,
+						Command: spec.Command,
+						Env:     envVars(spec.Env),
+						EnvFrom: envFroms(spec.EnvFrom),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (j *JobReconciler) objectMeta(work *suffiksv1.Work) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      work.Name,
+		Namespace: work.Namespace,
+	}
+}
+
+// jobCompleted reports whether job has run to completion.
+func jobCompleted(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobComplete && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
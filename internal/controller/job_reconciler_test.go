@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/suffiks/suffiks/internal/extension"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestJobReconciler_createOrUpdateJob(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := suffiksv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	j := &JobReconciler{Scheme: scheme, Client: c}
+
+	work := &suffiksv1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: "work", Namespace: "default", UID: "uid"},
+		Spec:       suffiksv1.WorkSpec{Image: "image:v1"},
+	}
+	key := client.ObjectKeyFromObject(work)
+
+	reconcile := func() error {
+		return j.createOrUpdateJob(ctx, work, work.Spec, &extension.Changeset{})
+	}
+	get := func() *batchv1.Job {
+		t.Helper()
+		job := &batchv1.Job{}
+		if err := c.Get(ctx, key, job); err != nil {
+			t.Fatal(err)
+		}
+		return job
+	}
+	image := func(job *batchv1.Job) string {
+		return job.Spec.Template.Spec.Containers[0].Image
+	}
+	wantRequeue := func(err error) {
+		t.Helper()
+		var requeue *RequeueError
+		if !errors.As(err, &requeue) {
+			t.Fatalf("expected RequeueError, got %v", err)
+		}
+	}
+
+	if err := reconcile(); err != nil {
+		t.Fatal(err)
+	}
+	created := get()
+
+	// Reconciling an unchanged pod template keeps the Job.
+	if err := reconcile(); err != nil {
+		t.Fatal(err)
+	}
+	if rv := get().ResourceVersion; rv != created.ResourceVersion {
+		t.Errorf("expected unchanged Job to be kept, resource version changed from %s to %s", created.ResourceVersion, rv)
+	}
+
+	// A completed Job is never replaced.
+	created.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	if err := c.Status().Update(ctx, created); err != nil {
+		t.Fatal(err)
+	}
+	work.Spec.Image = "image:v2"
+	if err := reconcile(); err != nil {
+		t.Fatal(err)
+	}
+	if got := image(get()); got != "image:v1" {
+		t.Errorf("expected completed Job to be kept, got image %q", got)
+	}
+
+	// A changed pod template replaces the Job, waiting for the old one to be deleted.
+	job := get()
+	job.Status.Conditions = nil
+	if err := c.Status().Update(ctx, job); err != nil {
+		t.Fatal(err)
+	}
+	job.Finalizers = []string{"test/finalizer"}
+	if err := c.Update(ctx, job); err != nil {
+		t.Fatal(err)
+	}
+	wantRequeue(reconcile())
+	wantRequeue(reconcile())
+
+	job = get()
+	job.Finalizers = nil
+	if err := c.Update(ctx, job); err != nil {
+		t.Fatal(err)
+	}
+	if err := reconcile(); err != nil {
+		t.Fatal(err)
+	}
+	if got := image(get()); got != "image:v2" {
+		t.Errorf("expected Job to be replaced, got image %q", got)
+	}
+}
@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// hashAnnotation is set on owned objects to the hash of the owner they were
+// applied for. It's used to tell writes done by suffiks apart from others.
+const hashAnnotation = "suffiks.com/hash"
+
+// ownedPredicate filters events of owned objects.
+//
+// Creates are ignored, as suffiks creates the objects itself. Updates changing
+// the hash annotation are writes done by suffiks, and are ignored as well.
+// Deletes and spec changes done by others are drift, see isDrift, while
+// status changes are passed on to keep the status of the owner up to date.
+func ownedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil || isOwnWrite(e.ObjectOld, e.ObjectNew) {
+				return false
+			}
+			return isDrift(e.ObjectOld, e.ObjectNew) || statusChanged(e.ObjectOld, e.ObjectNew)
+		},
+	}
+}
+
+func isOwnWrite(old, new client.Object) bool {
+	hash := new.GetAnnotations()[hashAnnotation]
+	return hash != "" && hash != old.GetAnnotations()[hashAnnotation]
+}
+
+// isDrift reports whether the spec of an owned object was changed by someone else.
+// Scaling is not considered drift, as replicas are commonly managed by autoscalers.
+// Kinds without a generation, such as Services, are only compared by their spec.
+func isDrift(old, new client.Object) bool {
+	if isOwnWrite(old, new) {
+		return false
+	}
+	if new.GetGeneration() != 0 && old.GetGeneration() == new.GetGeneration() {
+		return false
+	}
+	return fieldChanged(old, new, "spec", "replicas")
+}
+
+func statusChanged(old, new client.Object) bool {
+	return fieldChanged(old, new, "status")
+}
+
+// fieldChanged reports whether the top level field differs between old and new,
+// not taking the given nested fields into account.
+func fieldChanged(old, new client.Object, field string, ignore ...string) bool {
+	oldU, err := runtime.DefaultUnstructuredConverter.ToUnstructured(old)
+	if err != nil {
+		return true
+	}
+	newU, err := runtime.DefaultUnstructuredConverter.ToUnstructured(new)
+	if err != nil {
+		return true
+	}
+
+	for _, name := range ignore {
+		unstructured.RemoveNestedField(oldU, field, name)
+		unstructured.RemoveNestedField(newU, field, name)
+	}
+	return !equality.Semantic.DeepEqual(oldU[field], newU[field])
+}
+
+// driftSet contains the owners of objects which drifted from what suffiks applied.
+type driftSet struct {
+	m sync.Map
+}
+
+func (d *driftSet) add(key types.NamespacedName)    { d.m.Store(key, struct{}{}) }
+func (d *driftSet) remove(key types.NamespacedName) { d.m.Delete(key) }
+
+func (d *driftSet) has(key types.NamespacedName) bool {
+	_, ok := d.m.Load(key)
+	return ok
+}
+
+// driftHandler enqueues the owner of an object, and records it in drifted
+// when the event is caused by drift. Owners being deleted are not recorded,
+// as they are not synced again.
+type driftHandler struct {
+	handler.EventHandler
+	drifted *driftSet
+
+	// owners reads the owners of the objects, created using newOwner.
+	owners   client.Reader
+	newOwner func() client.Object
+}
+
+func (h *driftHandler) Update(ctx context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+	if isDrift(e.ObjectOld, e.ObjectNew) {
+		q = &recordingQueue{RateLimitingInterface: q, ctx: ctx, handler: h}
+	}
+	h.EventHandler.Update(ctx, e, q)
+}
+
+func (h *driftHandler) Delete(ctx context.Context, e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+	h.EventHandler.Delete(ctx, e, &recordingQueue{RateLimitingInterface: q, ctx: ctx, handler: h})
+}
+
+// record records the owner as drifted, unless it's gone or being deleted.
+func (h *driftHandler) record(ctx context.Context, key types.NamespacedName) {
+	owner := h.newOwner()
+	if err := h.owners.Get(ctx, key, owner); err != nil || owner.GetDeletionTimestamp() != nil {
+		h.drifted.remove(key)
+		return
+	}
+	h.drifted.add(key)
+}
+
+// recordingQueue records requests added to the queue as drifted.
+type recordingQueue struct {
+	workqueue.RateLimitingInterface
+	ctx     context.Context
+	handler *driftHandler
+}
+
+func (q *recordingQueue) Add(item any) {
+	if req, ok := item.(reconcile.Request); ok {
+		q.handler.record(q.ctx, req.NamespacedName)
+	}
+	q.RateLimitingInterface.Add(item)
+}
@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestOwnedPredicate(t *testing.T) {
+	deployment := func(hash string, generation int64, image string, replicas, available int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "app",
+				Generation:  generation,
+				Annotations: map[string]string{hashAnnotation: hash},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To(replicas),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+				},
+			},
+			Status: appsv1.DeploymentStatus{AvailableReplicas: available},
+		}
+	}
+	service := func(hash string, port int32) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "app",
+				Annotations: map[string]string{hashAnnotation: hash},
+			},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: port}}},
+		}
+	}
+
+	tests := map[string]struct {
+		old, new client.Object
+		want     bool
+		drift    bool
+	}{
+		"own write": {
+			old:  deployment("a", 1, "v1", 1, 1),
+			new:  deployment("b", 2, "v2", 1, 1),
+			want: false,
+		},
+		"spec changed by others": {
+			old:   deployment("a", 1, "v1", 1, 1),
+			new:   deployment("a", 2, "v2", 1, 1),
+			want:  true,
+			drift: true,
+		},
+		"scaled": {
+			old:  deployment("a", 1, "v1", 1, 1),
+			new:  deployment("a", 2, "v1", 3, 1),
+			want: false,
+		},
+		"hash annotation removed": {
+			old:   deployment("a", 1, "v1", 1, 1),
+			new:   deployment("", 2, "v2", 1, 1),
+			want:  true,
+			drift: true,
+		},
+		"status changed": {
+			old:  deployment("a", 1, "v1", 1, 0),
+			new:  deployment("a", 1, "v1", 1, 1),
+			want: true,
+		},
+		"nothing changed": {
+			old:  deployment("a", 1, "v1", 1, 1),
+			new:  deployment("a", 1, "v1", 1, 1),
+			want: false,
+		},
+		"service changed by others": {
+			old:   service("a", 80),
+			new:   service("a", 8080),
+			want:  true,
+			drift: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := event.UpdateEvent{ObjectOld: tc.old, ObjectNew: tc.new}
+			if got := ownedPredicate().Update(e); got != tc.want {
+				t.Errorf("expected predicate to return %v, got %v", tc.want, got)
+			}
+			if got := isDrift(tc.old, tc.new); got != tc.drift {
+				t.Errorf("expected drift to be %v, got %v", tc.drift, got)
+			}
+		})
+	}
+
+	if ownedPredicate().Create(event.CreateEvent{Object: deployment("a", 1, "v1", 1, 1)}) {
+		t.Error("expected create events to be ignored")
+	}
+	if !ownedPredicate().Delete(event.DeleteEvent{Object: deployment("a", 1, "v1", 1, 1)}) {
+		t.Error("expected delete events to be passed on")
+	}
+}
+
+func TestDriftHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := suffiksv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	owner := types.NamespacedName{Namespace: "default", Name: "app"}
+	deleting := types.NamespacedName{Namespace: "default", Name: "deleting"}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&suffiksv1.Application{ObjectMeta: metav1.ObjectMeta{Name: owner.Name, Namespace: owner.Namespace}},
+		&suffiksv1.Application{ObjectMeta: metav1.ObjectMeta{
+			Name:              deleting.Name,
+			Namespace:         deleting.Namespace,
+			DeletionTimestamp: ptr.To(metav1.Now()),
+			Finalizers:        []string{suffiksFinalizer},
+		}},
+	).Build()
+
+	enqueueOwner := func(o client.Object, q workqueue.RateLimitingInterface) {
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}})
+	}
+
+	drifted := &driftSet{}
+	h := &driftHandler{
+		EventHandler: handler.Funcs{
+			UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+				enqueueOwner(e.ObjectNew, q)
+			},
+			DeleteFunc: func(_ context.Context, e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+				enqueueOwner(e.Object, q)
+			},
+		},
+		drifted:  drifted,
+		owners:   c,
+		newOwner: func() client.Object { return &suffiksv1.Application{} },
+	}
+
+	q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer q.ShutDown()
+
+	ctx := context.Background()
+	meta := metav1.ObjectMeta{Name: owner.Name, Namespace: owner.Namespace, Generation: 1}
+	status := event.UpdateEvent{
+		ObjectOld: &appsv1.Deployment{ObjectMeta: meta},
+		ObjectNew: &appsv1.Deployment{ObjectMeta: meta, Status: appsv1.DeploymentStatus{Replicas: 1}},
+	}
+	h.Update(ctx, status, q)
+	if q.Len() != 1 {
+		t.Fatalf("expected owner to be enqueued, queue length is %d", q.Len())
+	}
+	if drifted.has(owner) {
+		t.Fatal("expected status changes to not be recorded as drift")
+	}
+
+	h.Delete(ctx, event.DeleteEvent{Object: &appsv1.Deployment{ObjectMeta: meta}}, q)
+	if !drifted.has(owner) {
+		t.Fatal("expected delete to be recorded as drift")
+	}
+
+	h.Delete(ctx, event.DeleteEvent{Object: &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deleting.Name, Namespace: deleting.Namespace}}}, q)
+	if drifted.has(deleting) {
+		t.Fatal("expected delete of an owner being deleted to not be recorded as drift")
+	}
+
+	h.Delete(ctx, event.DeleteEvent{Object: &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "gone", Namespace: "default"}}}, q)
+	if drifted.has(types.NamespacedName{Namespace: "default", Name: "gone"}) {
+		t.Fatal("expected delete of a missing owner to not be recorded as drift")
+	}
+
+	drifted.remove(owner)
+	if drifted.has(owner) {
+		t.Fatal("expected drift to be removed")
+	}
+}
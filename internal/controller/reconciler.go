@@ -2,28 +2,43 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/suffiks/suffiks/internal/extension"
 	"github.com/suffiks/suffiks/internal/tracing"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logr "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 type Reconciler[V Object] interface {
 	NewObject() V
 	CreateOrUpdate(ctx context.Context, obj V, changeset *extension.Changeset) error
 	Delete(ctx context.Context, obj V) error
-	UpdateStatus(ctx context.Context, obj V, extensions []string) (changes bool, err error)
+	// UpdateStatus updates the status of obj. Statuses contains the status
+	// reported by each extension during the sync. When nil, no sync was done and
+	// the existing statuses of extensions in extensions are kept.
+	UpdateStatus(ctx context.Context, obj V, extensions []string, statuses map[string]suffiksv1.OwnerExtensionStatus) (changes bool, err error)
 	IsModified(ctx context.Context, obj V) (bool, error)
 	Extensions(obj V) []string
 	// This might be required for some reconcilers, but not for others.
@@ -37,13 +52,55 @@ type ReconcilerDefault[V Object] interface {
 	Default(ctx context.Context, obj V) error
 }
 
+// ReconcilerValidate is implemented by reconcilers validating the fields they
+// own before extensions are asked to validate the object.
+type ReconcilerValidate[V Object] interface {
+	Validate(ctx context.Context, obj V) field.ErrorList
+}
+
+// WorkloadStatus describes the state of the workload created for an object.
+type WorkloadStatus struct {
+	Ready       bool
+	Progressing bool
+	Message     string
+}
+
+// ReconcilerWorkload is implemented by reconcilers able to report the state of
+// the workload they create. It is used to populate the Ready and Progressing
+// conditions of the object.
+type ReconcilerWorkload[V Object] interface {
+	WorkloadStatus(ctx context.Context, obj V) (WorkloadStatus, error)
+}
+
 const suffiksFinalizer = "suffiks.suffiks.com/finalizer"
 
+// unavailableRequeueAfter is how long to wait before retrying an object paused
+// by an unavailable extension.
+const unavailableRequeueAfter = time.Minute
+
+// skippedRequeueAfter is how long to wait before retrying an object which
+// wasn't applied due to ignored extension failures.
+const skippedRequeueAfter = time.Minute
+
+// RequeueError is returned by a Reconciler when the object can't be
+// reconciled yet, and should be retried after After without being reported as
+// a failure.
+type RequeueError struct {
+	After  time.Duration
+	Reason string
+}
+
+func (e *RequeueError) Error() string {
+	return fmt.Sprintf("requeue after %s: %s", e.After, e.Reason)
+}
+
 type ReconcilerWrapper[V Object] struct {
 	client.Client
 
 	Child         traceWrapper[V] // Reconciler[V]
 	CRDController *ExtensionController
+
+	drifted driftSet
 }
 
 func New[V Object](client client.Client, child Reconciler[V], crdController *ExtensionController) *ReconcilerWrapper[V] {
@@ -73,10 +130,14 @@ func (r *ReconcilerWrapper[V]) Reconcile(ctx context.Context, req ctrl.Request)
 
 	v := r.Child.NewObject()
 	if err := r.Get(ctx, req.NamespacedName, v); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.drifted.remove(req.NamespacedName)
+		}
 		return r.handleError(ctx, err, "unable to fetch "+kind, client.IgnoreNotFound)
 	}
 
 	if v.GetDeletionTimestamp() != nil {
+		r.drifted.remove(req.NamespacedName)
 		if !controllerutil.ContainsFinalizer(v, suffiksFinalizer) {
 			return ctrl.Result{}, nil
 		}
@@ -86,7 +147,7 @@ func (r *ReconcilerWrapper[V]) Reconcile(ctx context.Context, req ctrl.Request)
 			return r.handleError(ctx, err, "unable to delete from child")
 		}
 
-		if err := r.CRDController.Delete(ctx, v); err != nil {
+		if err := r.CRDController.Delete(ctx, v, r.Child.Extensions(v)); err != nil {
 			log.Error(err, "unable to delete from extensions, will try again later")
 			return r.handleError(ctx, err, "unable to delete from extensions")
 		}
@@ -115,11 +176,30 @@ func (r *ReconcilerWrapper[V]) Reconcile(ctx context.Context, req ctrl.Request)
 	if err != nil {
 		return r.handleError(ctx, err, "unable to check if application is modified", client.IgnoreNotFound)
 	}
+	if r.drifted.has(req.NamespacedName) {
+		span.AddEvent("owned object drifted")
+		modified = true
+		// Repairing drift takes back the fields changed by others.
+		ctx = withForceOwnership(ctx)
+	}
+	if !modified {
+		// Extensions no longer selected are deleted by the sync, and newly
+		// selected ones applied.
+		changed, err := r.CRDController.SelectionChanged(ctx, v, r.Child.Extensions(v))
+		if err != nil {
+			return r.handleError(ctx, err, "unable to check extension selection")
+		}
+		if changed {
+			span.AddEvent("extension selection changed")
+			modified = true
+		}
+	}
 	if !modified {
-		changes, err := r.Child.UpdateStatus(ctx, v, r.Child.Extensions(v))
+		changes, err := r.Child.UpdateStatus(ctx, v, r.Child.Extensions(v), nil)
 		if err != nil {
 			return r.handleError(ctx, err, "unable to update child status on non-modified object")
 		}
+		changes = r.updateConditions(ctx, v) || changes
 
 		if changes {
 			err = r.Status().Update(ctx, v)
@@ -129,10 +209,51 @@ func (r *ReconcilerWrapper[V]) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	result, err := r.CRDController.Sync(ctx, v)
+	if errors.Is(err, extension.ErrUnavailable) {
+		// Wait for the extension to come back before touching any resources.
+		// The extension reconciler resyncs the object when it does.
+		span.AddEvent("paused", trace.WithAttributes(attribute.String("reason", err.Error())))
+		log.Info("extension unavailable, pausing reconcile", "reason", err.Error())
+
+		changes := setCondition(v, metav1.Condition{
+			Type:    suffiksv1.ConditionExtensionsHealthy,
+			Status:  metav1.ConditionFalse,
+			Reason:  suffiksv1.ReasonExtensionUnavailable,
+			Message: err.Error(),
+		})
+		if r.setReadyCondition(ctx, v) || changes {
+			if err := r.Status().Update(ctx, v); err != nil {
+				return r.handleError(ctx, err, "unable to update status on paused object")
+			}
+		}
+		return ctrl.Result{RequeueAfter: unavailableRequeueAfter}, nil
+	}
 	if err != nil {
+		changes := setSyncedCondition(v, err)
+		if r.updateConditions(ctx, v) || changes {
+			if uerr := r.Status().Update(ctx, v); uerr != nil {
+				log.Error(uerr, "unable to update status with sync failure")
+			}
+		}
 		return r.handleError(ctx, err, "unable to sync CRD")
 	}
 
+	if skipped := appliedExtensions(result.Ignored.Slice(), r.Child.Extensions(v)); len(skipped) > 0 {
+		// Applying without the responses of these extensions would remove
+		// what they added before, so nothing is applied and the status is
+		// kept until they succeed again.
+		span.AddEvent("apply skipped", trace.WithAttributes(attribute.StringSlice("extensions", skipped)))
+		log.Info("ignored extension failures, skipping apply", "extensions", skipped)
+
+		changes := setApplySkippedCondition(v, result.Warnings.Slice())
+		if r.updateConditions(ctx, v) || changes {
+			if err := r.Status().Update(ctx, v); err != nil {
+				return r.handleError(ctx, err, "unable to update status on skipped apply")
+			}
+		}
+		return ctrl.Result{RequeueAfter: skippedRequeueAfter}, nil
+	}
+
 	for _, old := range r.Child.Extensions(v) {
 		if !result.Extensions.Contains(old) {
 			if err := r.CRDController.DeleteExtension(ctx, v, old); err != nil {
@@ -143,13 +264,33 @@ func (r *ReconcilerWrapper[V]) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	if err := r.Child.CreateOrUpdate(ctx, v, result.Changeset); err != nil {
+		var requeue *RequeueError
+		if errors.As(err, &requeue) {
+			span.AddEvent("requeue", trace.WithAttributes(attribute.String("reason", requeue.Reason)))
+			log.V(1).Info("requeueing", "reason", requeue.Reason, "after", requeue.After)
+			return ctrl.Result{RequeueAfter: requeue.After}, nil
+		}
+		changes := setApplyFailedCondition(v, err)
+		if r.updateConditions(ctx, v) || changes {
+			if uerr := r.Status().Update(ctx, v); uerr != nil {
+				log.Error(uerr, "unable to update status with apply failure")
+			}
+		}
 		return r.handleError(ctx, err, "unable to create or update")
 	}
+	r.drifted.remove(req.NamespacedName)
+	r.CRDController.RecordEvents(ctx, v, result)
 
-	changes, err := r.Child.UpdateStatus(ctx, v, result.Extensions.Slice())
+	changes, err := r.Child.UpdateStatus(ctx, v, result.Extensions.Slice(), result.Changeset.Statuses())
 	if err != nil {
 		return r.handleError(ctx, err, "unable to update child status")
 	}
+	if warnings := result.Warnings.Slice(); len(warnings) > 0 {
+		changes = setIgnoredFailuresCondition(v, warnings) || changes
+	} else {
+		changes = setSyncedCondition(v, nil) || changes
+	}
+	changes = r.updateConditions(ctx, v) || changes
 
 	if changes {
 		err = r.Status().Update(ctx, v)
@@ -164,13 +305,53 @@ func (r *ReconcilerWrapper[V]) SetupWithManager(mgr ctrl.Manager) (err error) {
 		Watches(r.Child.NewObject(), &handler.EnqueueRequestForObject{}).
 		For(r.Child.NewObject(), builder.OnlyMetadata)
 
+	// Owned objects are watched with predicates ignoring writes done by the
+	// reconciler itself. Drift results in a full sync, while status changes
+	// only update the status of the owner.
+	owner := handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), r.Child.NewObject(), handler.OnlyControllerOwner())
 	for _, o := range r.Child.Owns() {
-		bldr = bldr.Owns(o)
+		h := &driftHandler{
+			EventHandler: owner,
+			drifted:      &r.drifted,
+			owners:       mgr.GetClient(),
+			newOwner:     func() client.Object { return r.Child.NewObject() },
+		}
+		bldr = bldr.Watches(o, h, builder.WithPredicates(ownedPredicate()))
 	}
 
+	// Namespace labels select extensions, so owners are checked again when
+	// the labels of their namespace change.
+	gvk, err := apiutil.GVKForObject(r.Child.NewObject(), mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+	bldr = bldr.WatchesMetadata(
+		&corev1.Namespace{},
+		handler.EnqueueRequestsFromMapFunc(namespaceOwners(mgr.GetClient(), gvk)),
+		builder.WithPredicates(predicate.LabelChangedPredicate{}),
+	)
+
 	return bldr.Complete(r)
 }
 
+// namespaceOwners returns a map func enqueueing every owner of kind gvk in a namespace.
+func namespaceOwners(c client.Reader, gvk schema.GroupVersionKind) handler.MapFunc {
+	return func(ctx context.Context, ns client.Object) []reconcile.Request {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.List(ctx, list, client.InNamespace(ns.GetName())); err != nil {
+			logr.FromContext(ctx).Error(err, "unable to list owners in namespace", "namespace", ns.GetName())
+			return nil
+		}
+
+		reqs := make([]reconcile.Request, 0, len(list.Items))
+		for _, item := range list.Items {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&item)})
+		}
+		return reqs
+	}
+}
+
 // handleError will, if the error is not nil:
 // - record the error in the span
 // - log the error with the msg
@@ -201,3 +382,16 @@ func (r *ReconcilerWrapper[V]) handleError(ctx context.Context, err error, msg s
 	log.Error(err, msg)
 	return ctrl.Result{}, err
 }
+
+// appliedExtensions returns the extensions in ignored which were part of the
+// last apply, as listed by applied.
+func appliedExtensions(ignored, applied []string) []string {
+	var ret []string
+	for _, name := range ignored {
+		if slices.Contains(applied, name) {
+			ret = append(ret, name)
+		}
+	}
+	slices.Sort(ret)
+	return ret
+}
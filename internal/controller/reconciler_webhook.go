@@ -2,7 +2,10 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/suffiks/suffiks/extension/protogen"
@@ -10,15 +13,21 @@ import (
 	"github.com/suffiks/suffiks/internal/tracing"
 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"go.opentelemetry.io/otel/attribute"
+	admissionv1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	logr "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-var _ admission.CustomValidator = &ReconcilerWrapper[*suffiksv1.Application]{}
+var (
+	_ admission.CustomValidator = &ReconcilerWrapper[*suffiksv1.Application]{}
+	_ admission.CustomValidator = &ReconcilerWrapper[*suffiksv1.Work]{}
+	_ admission.Handler         = &defaulter[*suffiksv1.Application]{}
+)
 
 type namespaceName interface {
 	GetNamespace() string
@@ -66,9 +75,16 @@ func (r *ReconcilerWrapper[V]) validate(ctx context.Context, typ protogen.Valida
 
 	span.SetAttributes(attribute.String("name", v.GetName()), attribute.String("namespace", v.GetNamespace()))
 
-	if err := r.CRDController.Validate(ctx, typ, newV, oldV); err != nil {
+	if validator, ok := r.Child.Reconciler.(ReconcilerValidate[V]); ok && isSet {
+		if errs := validator.Validate(ctx, newV); len(errs) > 0 {
+			return nil, apierrors.NewInvalid(v.GetObjectKind().GroupVersionKind().GroupKind(), v.GetName(), errs)
+		}
+	}
+
+	warnings, err := r.CRDController.Validate(ctx, typ, newV, oldV)
+	if err != nil {
 		if ferr, ok := err.(FieldErrsWrapper); ok {
-			return nil, apierrors.NewInvalid(
+			return warnings, apierrors.NewInvalid(
 				v.GetObjectKind().GroupVersionKind().GroupKind(),
 				v.GetName(),
 				field.ErrorList(ferr),
@@ -77,13 +93,19 @@ func (r *ReconcilerWrapper[V]) validate(ctx context.Context, typ protogen.Valida
 
 		log.Error(err, "extension validation error")
 		span.RecordError(err)
-		return nil, apierrors.NewInternalError(err)
+		return warnings, apierrors.NewInternalError(err)
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 func (r *ReconcilerWrapper[V]) Default(ctx context.Context, obj runtime.Object) error {
+	_, err := r.defaults(ctx, obj)
+	return err
+}
+
+// defaults sets the default values of obj, returning the warnings of extensions.
+func (r *ReconcilerWrapper[V]) defaults(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	v := obj.(V)
 	kind := r.Child.NewObject().GetObjectKind().GroupVersionKind().Kind
 	if kind == "" {
@@ -102,23 +124,25 @@ func (r *ReconcilerWrapper[V]) Default(ctx context.Context, obj runtime.Object)
 
 	if defaulter, ok := r.Child.Reconciler.(ReconcilerDefault[V]); ok {
 		if err := defaulter.Default(ctx, v); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	defaults, err := r.CRDController.Default(ctx, v)
 	if err != nil {
-		return fmt.Errorf("Default crdmanager: %w", err)
+		return nil, fmt.Errorf("Default crdmanager: %w", err)
 	}
 
+	var warnings admission.Warnings
 	changeset := &extension.Changeset{}
 	for _, d := range defaults {
+		warnings = append(warnings, d.GetWarnings()...)
 		if err := changeset.AddMergePatch(d.GetSpec()); err != nil {
-			return err
+			return warnings, err
 		}
 	}
 
-	return changeset.Apply(v)
+	return warnings, changeset.Apply(v)
 }
 
 func (r *ReconcilerWrapper[V]) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
@@ -133,10 +157,56 @@ func (r *ReconcilerWrapper[V]) ValidateDelete(ctx context.Context, obj runtime.O
 	return r.validate(ctx, protogen.ValidationType_DELETE, nil, obj)
 }
 
+// defaulter is the mutating webhook of a ReconcilerWrapper. Unlike the webhook
+// built for an admission.CustomDefaulter, it returns the warnings of extensions.
+type defaulter[V Object] struct {
+	wrapper *ReconcilerWrapper[V]
+	decoder *admission.Decoder
+}
+
+func (d *defaulter[V]) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Delete {
+		return admission.Allowed("")
+	}
+	ctx = admission.NewContextWithRequest(ctx, req)
+
+	obj := d.wrapper.Child.NewObject()
+	if err := d.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	warnings, err := d.wrapper.defaults(ctx, obj)
+	if err != nil {
+		resp := admission.Denied(err.Error())
+		var apiStatus apierrors.APIStatus
+		if errors.As(err, &apiStatus) {
+			status := apiStatus.Status()
+			resp.Result = &status
+		}
+		return resp.WithWarnings(warnings...)
+	}
+
+	marshalled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshalled).WithWarnings(warnings...)
+}
+
 func (r *ReconcilerWrapper[V]) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	gvk, err := apiutil.GVKForObject(r.Child.NewObject(), mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	// Registered at the path the webhook builder uses for defaulters.
+	path := "/mutate-" + strings.ReplaceAll(gvk.Group, ".", "-") + "-" + gvk.Version + "-" + strings.ToLower(gvk.Kind)
+	mgr.GetWebhookServer().Register(path, &admission.Webhook{
+		Handler: &defaulter[V]{wrapper: r, decoder: admission.NewDecoder(mgr.GetScheme())},
+	})
+
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r.Child.NewObject()).
-		WithDefaulter(r).
 		WithValidator(r).
 		// TODO(thokra): Currently, the webhook builder doesn't expose the webhook ContextFunc.
 		// This requires a change in controller-runtime.
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suffiks/suffiks/extension/protogen"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestDefaulter_Handle(t *testing.T) {
+	ext := &mockExtension{
+		name: "docs",
+		spec: suffiksv1.ExtensionSpec{Webhooks: suffiksv1.ExtensionWebhooks{Defaulting: true}},
+		def: func(context.Context, *protogen.SyncRequest) (*protogen.DefaultResponse, error) {
+			return &protogen.DefaultResponse{
+				Spec:     []byte(`{"spec":{"port":8080}}`),
+				Warnings: []string{"spec.docs is deprecated"},
+			}, nil
+		},
+	}
+	r := New[*suffiksv1.Application](nil, &AppReconciler{}, NewExtensionController(mockManager{ext}))
+
+	scheme := runtime.NewScheme()
+	if err := suffiksv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	d := &defaulter[*suffiksv1.Application]{wrapper: r, decoder: admission.NewDecoder(scheme)}
+
+	raw, err := json.Marshal(&suffiksv1.Application{
+		TypeMeta:   metav1.TypeMeta{Kind: "Application", APIVersion: "suffiks.com/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team"},
+		Spec:       suffiksv1.ApplicationSpec{Image: "image"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := d.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}})
+	if !resp.Allowed {
+		t.Fatalf("expected the request to be allowed, got %v", resp.Result)
+	}
+	if diff := cmp.Diff([]string{"spec.docs is deprecated"}, resp.Warnings); diff != "" {
+		t.Errorf("unexpected warnings (-want +got):\n%s", diff)
+	}
+	if len(resp.Patches) == 0 {
+		t.Error("expected the defaults to be patched")
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/suffiks/suffiks/internal/extension"
 	"github.com/suffiks/suffiks/internal/tracing"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 )
 
 type traceWrapper[V Object] struct {
@@ -23,10 +24,10 @@ func (t *traceWrapper[V]) Delete(ctx context.Context, obj V) error {
 	return t.Reconciler.Delete(ctx, obj)
 }
 
-func (t *traceWrapper[V]) UpdateStatus(ctx context.Context, obj V, extensions []string) (bool, error) {
+func (t *traceWrapper[V]) UpdateStatus(ctx context.Context, obj V, extensions []string, statuses map[string]suffiksv1.OwnerExtensionStatus) (bool, error) {
 	ctx, span := tracing.Start(ctx, "UpdateStatus")
 	defer span.End()
-	return t.Reconciler.UpdateStatus(ctx, obj, extensions)
+	return t.Reconciler.UpdateStatus(ctx, obj, extensions, statuses)
 }
 
 func (t *traceWrapper[V]) IsModified(ctx context.Context, obj V) (bool, error) {
@@ -1,6 +1,11 @@
 package controller
 
-import "sigs.k8s.io/controller-runtime/pkg/client"
+import (
+	"slices"
+
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
 
 type Object interface {
 	client.Object
@@ -18,3 +23,24 @@ func mergeMaps(maps ...map[string]string) map[string]string {
 	}
 	return ret
 }
+
+// extensionStatuses returns the statuses to store for extensions.
+// When reported is nil the current statuses are used. Statuses of extensions not
+// in extensions are dropped, so they are cleared when an extension no longer runs.
+func extensionStatuses(current, reported map[string]suffiksv1.OwnerExtensionStatus, extensions []string) map[string]suffiksv1.OwnerExtensionStatus {
+	if reported == nil {
+		reported = current
+	}
+
+	var ret map[string]suffiksv1.OwnerExtensionStatus
+	for name, status := range reported {
+		if !slices.Contains(extensions, name) {
+			continue
+		}
+		if ret == nil {
+			ret = map[string]suffiksv1.OwnerExtensionStatus{}
+		}
+		ret[name] = status
+	}
+	return ret
+}
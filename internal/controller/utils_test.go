@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 )
 
 func TestMergeMaps(t *testing.T) {
@@ -67,3 +68,55 @@ func TestMergeMaps(t *testing.T) {
 		})
 	}
 }
+
+func TestExtensionStatuses(t *testing.T) {
+	t.Parallel()
+
+	ingress := suffiksv1.OwnerExtensionStatus{Phase: "Ready", Outputs: map[string]string{"url": "https://app.example.com"}}
+	database := suffiksv1.OwnerExtensionStatus{Phase: "Pending", Message: "Waiting for database"}
+
+	tests := map[string]struct {
+		current    map[string]suffiksv1.OwnerExtensionStatus
+		reported   map[string]suffiksv1.OwnerExtensionStatus
+		extensions []string
+		want       map[string]suffiksv1.OwnerExtensionStatus
+	}{
+		"empty": {
+			reported:   map[string]suffiksv1.OwnerExtensionStatus{},
+			extensions: []string{"ingress"},
+		},
+		"reported": {
+			current:    map[string]suffiksv1.OwnerExtensionStatus{"ingress": {Phase: "Pending"}},
+			reported:   map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress},
+			extensions: []string{"ingress"},
+			want:       map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress},
+		},
+		"not reported during sync": {
+			current:    map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress, "database": database},
+			reported:   map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress},
+			extensions: []string{"ingress", "database"},
+			want:       map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress},
+		},
+		"keep current without sync": {
+			current:    map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress, "database": database},
+			extensions: []string{"ingress", "database"},
+			want:       map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress, "database": database},
+		},
+		"extension no longer runs": {
+			current:    map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress, "database": database},
+			extensions: []string{"ingress"},
+			want:       map[string]suffiksv1.OwnerExtensionStatus{"ingress": ingress},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got := extensionStatuses(tc.current, tc.reported, tc.extensions)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,11 +3,16 @@ package extension
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"sync"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/suffiks/suffiks/extension/protogen"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -22,6 +27,9 @@ type Changeset struct {
 	mergePatch     []byte
 	initContainers []v1.Container
 	sidecars       []v1.Container
+	volumes        []v1.Volume
+	volumeMounts   []v1.VolumeMount
+	statuses       map[string]suffiksv1.OwnerExtensionStatus
 }
 
 func (c *Changeset) Add(resp *protogen.Response) error {
@@ -103,16 +111,83 @@ func (c *Changeset) Add(resp *protogen.Response) error {
 		}()
 
 		c.initContainers = append(c.initContainers, container)
+	case *protogen.Response_Volume:
+		volume, err := volumeFromProto(r.Volume)
+		if err != nil {
+			return err
+		}
+		c.volumes = append(c.volumes, volume)
+	case *protogen.Response_VolumeMount:
+		mount, err := volumeMountFromProto(r.VolumeMount)
+		if err != nil {
+			return err
+		}
+		c.volumeMounts = append(c.volumeMounts, mount)
 	default:
 		return fmt.Errorf("unexpected response type: %T", r)
 	}
 	return nil
 }
 
+// SetStatus sets the status reported by the named extension.
+// A later status from the same extension replaces the earlier one.
+func (c *Changeset) SetStatus(extension string, status *protogen.ExtensionStatus) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.statuses == nil {
+		c.statuses = map[string]suffiksv1.OwnerExtensionStatus{}
+	}
+	c.statuses[extension] = suffiksv1.OwnerExtensionStatus{
+		Phase:   status.GetPhase(),
+		Message: status.GetMessage(),
+		Outputs: maps.Clone(status.GetOutputs()),
+	}
+}
+
+// Statuses returns the statuses reported by extensions, keyed by the name of
+// the extension. The returned map is never nil.
+func (c *Changeset) Statuses() map[string]suffiksv1.OwnerExtensionStatus {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	statuses := make(map[string]suffiksv1.OwnerExtensionStatus, len(c.statuses))
+	for name, status := range c.statuses {
+		statuses[name] = status
+	}
+	return statuses
+}
+
+// Apply applies the changeset to v.
+//
+// Labels and annotations are merged into the object metadata. Environment
+// variables, envFrom sources, init containers, sidecars, volumes and volume
+// mounts are injected into the pod template of supported workload kinds, see
+// applyPodSpec for the rules. The merge patch is applied last, so it can
+// override anything set before it.
 func (changeset *Changeset) Apply(v client.Object) error {
 	v.SetLabels(mergeMaps(v.GetLabels(), changeset.labels))
 	v.SetAnnotations(mergeMaps(v.GetAnnotations(), changeset.annotations))
 
+	switch o := v.(type) {
+	case *appsv1.Deployment:
+		if err := changeset.applyPodSpec(&o.Spec.Template.Spec, o.Name); err != nil {
+			return fmt.Errorf("applyChangeset deployment: %w", err)
+		}
+	case *batchv1.Job:
+		if err := changeset.applyPodSpec(&o.Spec.Template.Spec, o.Name); err != nil {
+			return fmt.Errorf("applyChangeset job: %w", err)
+		}
+	case *batchv1.CronJob:
+		if err := changeset.applyPodSpec(&o.Spec.JobTemplate.Spec.Template.Spec, o.Name); err != nil {
+			return fmt.Errorf("applyChangeset cronjob: %w", err)
+		}
+	default:
+		if changeset.hasPodChanges() {
+			return fmt.Errorf("applyChangeset: unsupported kind %T for env, envFrom, containers or volumes", v)
+		}
+	}
+
 	if len(changeset.mergePatch) > 0 {
 		b, err := json.Marshal(v)
 		if err != nil {
@@ -130,6 +205,195 @@ func (changeset *Changeset) Apply(v client.Object) error {
 	return nil
 }
 
+func (c *Changeset) hasPodChanges() bool {
+	return len(c.environment) > 0 || len(c.envFrom) > 0 || len(c.initContainers) > 0 || len(c.sidecars) > 0 ||
+		len(c.volumes) > 0 || len(c.volumeMounts) > 0
+}
+
+// applyPodSpec injects the pod level changes into spec.
+//
+// The main container is the container named mainContainer, or the first
+// container if none match. The following rules apply:
+//   - Environment variables already set on the main container (from the
+//     workload spec) take precedence, and extension values with the same name
+//     are ignored.
+//   - Identical environment variables from multiple extensions are only added
+//     once. The same name with different values is a conflict and returns an
+//     error, as the result would otherwise depend on extension ordering.
+//   - EnvFrom sources referencing an already referenced ConfigMap or Secret are
+//     only added once.
+//   - Init containers and sidecars are identified by name. Identical containers
+//     are only added once, while different containers sharing a name, or
+//     sharing the name of an existing container, return an error.
+//   - Volumes are identified by name, and volume mounts of the main container
+//     by mount path, following the same rules as containers. Mounts must
+//     reference a volume of the pod.
+func (c *Changeset) applyPodSpec(spec *v1.PodSpec, mainContainer string) error {
+	if !c.hasPodChanges() {
+		return nil
+	}
+
+	if len(spec.Containers) == 0 {
+		return fmt.Errorf("no containers in pod spec")
+	}
+
+	main := &spec.Containers[0]
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == mainContainer {
+			main = &spec.Containers[i]
+			break
+		}
+	}
+
+	env, err := mergeEnv(main.Env, c.environment)
+	if err != nil {
+		return err
+	}
+	main.Env = env
+	main.EnvFrom = mergeEnvFrom(main.EnvFrom, c.envFrom)
+
+	spec.InitContainers, err = mergeContainers(spec.InitContainers, c.initContainers, spec.Containers)
+	if err != nil {
+		return fmt.Errorf("init container: %w", err)
+	}
+
+	spec.Volumes, err = mergeByKey(spec.Volumes, c.volumes, func(v v1.Volume) string { return v.Name })
+	if err != nil {
+		return fmt.Errorf("volume: %w", err)
+	}
+
+	mounts, err := mergeByKey(main.VolumeMounts, c.volumeMounts, func(m v1.VolumeMount) string { return m.MountPath })
+	if err != nil {
+		return fmt.Errorf("volume mount: %w", err)
+	}
+	if err := checkVolumeMounts(mounts[len(main.VolumeMounts):], spec.Volumes); err != nil {
+		return err
+	}
+	main.VolumeMounts = mounts
+
+	// Appending sidecars might reallocate spec.Containers, so main must not be used after this.
+	spec.Containers, err = mergeContainers(spec.Containers, c.sidecars, spec.InitContainers)
+	if err != nil {
+		return fmt.Errorf("sidecar: %w", err)
+	}
+
+	return nil
+}
+
+// mergeByKey appends add to existing, skipping identical duplicates.
+// Different items with the same key return an error.
+func mergeByKey[T any](existing, add []T, key func(T) string) ([]T, error) {
+	index := map[string]int{}
+	for i, item := range existing {
+		index[key(item)] = i
+	}
+
+	for _, item := range add {
+		k := key(item)
+		if i, ok := index[k]; ok {
+			if equality.Semantic.DeepEqual(existing[i], item) {
+				continue
+			}
+			return nil, fmt.Errorf("%q already exists", k)
+		}
+
+		index[k] = len(existing)
+		existing = append(existing, item)
+	}
+	return existing, nil
+}
+
+// checkVolumeMounts returns an error if any of mounts reference an unknown volume.
+func checkVolumeMounts(mounts []v1.VolumeMount, volumes []v1.Volume) error {
+	names := map[string]struct{}{}
+	for _, v := range volumes {
+		names[v.Name] = struct{}{}
+	}
+	for _, m := range mounts {
+		if _, ok := names[m.Name]; !ok {
+			return fmt.Errorf("volume mount %q references unknown volume %q", m.MountPath, m.Name)
+		}
+	}
+	return nil
+}
+
+func mergeEnv(existing, add []v1.EnvVar) ([]v1.EnvVar, error) {
+	fromSpec := map[string]struct{}{}
+	for _, e := range existing {
+		fromSpec[e.Name] = struct{}{}
+	}
+
+	added := map[string]v1.EnvVar{}
+	for _, e := range add {
+		if _, ok := fromSpec[e.Name]; ok {
+			continue
+		}
+
+		if prev, ok := added[e.Name]; ok {
+			if !equality.Semantic.DeepEqual(prev, e) {
+				return nil, fmt.Errorf("env %q set to conflicting values by extensions", e.Name)
+			}
+			continue
+		}
+
+		added[e.Name] = e
+		existing = append(existing, e)
+	}
+	return existing, nil
+}
+
+func mergeEnvFrom(existing, add []v1.EnvFromSource) []v1.EnvFromSource {
+	key := func(e v1.EnvFromSource) string {
+		switch {
+		case e.ConfigMapRef != nil:
+			return "configmap/" + e.Prefix + "/" + e.ConfigMapRef.Name
+		case e.SecretRef != nil:
+			return "secret/" + e.Prefix + "/" + e.SecretRef.Name
+		}
+		return ""
+	}
+
+	seen := map[string]struct{}{}
+	for _, e := range existing {
+		seen[key(e)] = struct{}{}
+	}
+
+	for _, e := range add {
+		k := key(e)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		existing = append(existing, e)
+	}
+	return existing
+}
+
+// mergeContainers appends add to existing, skipping identical duplicates.
+// reserved contains containers in the same pod which names can't be reused.
+func mergeContainers(existing, add, reserved []v1.Container) ([]v1.Container, error) {
+	names := map[string]int{}
+	for i, c := range existing {
+		names[c.Name] = i
+	}
+	for _, c := range reserved {
+		names[c.Name] = -1
+	}
+
+	for _, c := range add {
+		if i, ok := names[c.Name]; ok {
+			if i >= 0 && equality.Semantic.DeepEqual(existing[i], c) {
+				continue
+			}
+			return nil, fmt.Errorf("container %q already exists", c.Name)
+		}
+
+		names[c.Name] = len(existing)
+		existing = append(existing, c)
+	}
+	return existing, nil
+}
+
 func (c *Changeset) AddMergePatch(patch []byte) error {
 	if len(patch) == 0 {
 		return nil
@@ -6,8 +6,12 @@ import (
 	"unicode"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/suffiks/suffiks/extension/protogen"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -118,6 +122,219 @@ func TestChangeset_Add(t *testing.T) {
 	}
 }
 
+func TestChangeset_Apply(t *testing.T) {
+	deployment := func(env ...v1.EnvVar) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "app"},
+			Spec: appsv1.DeploymentSpec{
+				Template: v1.PodTemplateSpec{
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{{Name: "app", Image: "app", Env: env}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		responses []*protogen.Response
+		obj       *appsv1.Deployment
+		expected  *appsv1.Deployment
+		wantErr   bool
+	}{
+		"empty": {
+			obj:      deployment(),
+			expected: deployment(),
+		},
+		"env and envFrom": {
+			responses: []*protogen.Response{
+				respKeyValue("foo", "bar"),
+				respEnvFromSecret("secret", false),
+				respEnvFromConfigMap("configmap", true),
+			},
+			obj: deployment(),
+			expected: func() *appsv1.Deployment {
+				d := deployment(v1.EnvVar{Name: "foo", Value: "bar"})
+				d.Spec.Template.Spec.Containers[0].EnvFrom = []v1.EnvFromSource{
+					{SecretRef: &v1.SecretEnvSource{Optional: ptr.To(false), LocalObjectReference: v1.LocalObjectReference{Name: "secret"}}},
+					{ConfigMapRef: &v1.ConfigMapEnvSource{Optional: ptr.To(true), LocalObjectReference: v1.LocalObjectReference{Name: "configmap"}}},
+				}
+				return d
+			}(),
+		},
+		"spec env takes precedence": {
+			responses: []*protogen.Response{
+				respKeyValue("foo", "from-extension"),
+			},
+			obj:      deployment(v1.EnvVar{Name: "foo", Value: "from-spec"}),
+			expected: deployment(v1.EnvVar{Name: "foo", Value: "from-spec"}),
+		},
+		"duplicates are added once": {
+			responses: []*protogen.Response{
+				respKeyValue("foo", "bar"),
+				respKeyValue("foo", "bar"),
+				respEnvFromSecret("secret", false),
+				respEnvFromSecret("secret", false),
+				respInitContainer(container("init", "init")),
+				respInitContainer(container("init", "init")),
+				respContainer(container("sidecar", "sidecar")),
+				respContainer(container("sidecar", "sidecar")),
+			},
+			obj: deployment(),
+			expected: func() *appsv1.Deployment {
+				d := deployment(v1.EnvVar{Name: "foo", Value: "bar"})
+				d.Spec.Template.Spec.Containers[0].EnvFrom = []v1.EnvFromSource{
+					{SecretRef: &v1.SecretEnvSource{Optional: ptr.To(false), LocalObjectReference: v1.LocalObjectReference{Name: "secret"}}},
+				}
+				d.Spec.Template.Spec.InitContainers = []v1.Container{{Name: "init", Image: "init"}}
+				d.Spec.Template.Spec.Containers = append(d.Spec.Template.Spec.Containers, v1.Container{Name: "sidecar", Image: "sidecar"})
+				return d
+			}(),
+		},
+		"conflicting env": {
+			responses: []*protogen.Response{
+				respKeyValue("foo", "bar"),
+				respKeyValue("foo", "baz"),
+			},
+			obj:     deployment(),
+			wantErr: true,
+		},
+		"conflicting sidecar": {
+			responses: []*protogen.Response{
+				respContainer(container("sidecar", "sidecar")),
+				respContainer(container("sidecar", "other")),
+			},
+			obj:     deployment(),
+			wantErr: true,
+		},
+		"sidecar named as main container": {
+			responses: []*protogen.Response{
+				respContainer(container("app", "other")),
+			},
+			obj:     deployment(),
+			wantErr: true,
+		},
+		"volumes and mounts": {
+			responses: []*protogen.Response{
+				respVolume(secretVolume("cert", "cert-secret")),
+				respVolume(secretVolume("cert", "cert-secret")),
+				respVolumeMount("cert", "/etc/cert"),
+				respVolumeMount("cert", "/etc/cert"),
+			},
+			obj: deployment(),
+			expected: func() *appsv1.Deployment {
+				d := deployment()
+				d.Spec.Template.Spec.Volumes = []v1.Volume{{Name: "cert", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "cert-secret"}}}}
+				d.Spec.Template.Spec.Containers[0].VolumeMounts = []v1.VolumeMount{{Name: "cert", MountPath: "/etc/cert", ReadOnly: true}}
+				return d
+			}(),
+		},
+		"conflicting volume": {
+			responses: []*protogen.Response{
+				respVolume(secretVolume("cert", "cert-secret")),
+				respVolume(secretVolume("cert", "other-secret")),
+			},
+			obj:     deployment(),
+			wantErr: true,
+		},
+		"mount of unknown volume": {
+			responses: []*protogen.Response{
+				respVolumeMount("cert", "/etc/cert"),
+			},
+			obj:     deployment(),
+			wantErr: true,
+		},
+		"merge patch is applied last": {
+			responses: []*protogen.Response{
+				respKeyValue("foo", "bar"),
+				{OFResponse: &protogen.Response_MergePatch{MergePatch: []byte(`{"spec":{"replicas":2}}`)}},
+			},
+			obj: deployment(),
+			expected: func() *appsv1.Deployment {
+				d := deployment(v1.EnvVar{Name: "foo", Value: "bar"})
+				d.Spec.Replicas = ptr.To[int32](2)
+				return d
+			}(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &Changeset{}
+			for _, resp := range tc.responses {
+				if err := c.Add(resp); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := c.Apply(tc.obj)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			opts := cmpopts.EquateEmpty()
+			if diff := cmp.Diff(tc.expected, tc.obj, opts); diff != "" {
+				t.Errorf("unexpected deployment (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeset_ApplyJobs(t *testing.T) {
+	podSpec := func() v1.PodSpec {
+		return v1.PodSpec{Containers: []v1.Container{{Name: "work", Image: "work"}}}
+	}
+
+	c := &Changeset{}
+	for _, resp := range []*protogen.Response{respKeyValue("foo", "bar"), respContainer(container("sidecar", "sidecar"))} {
+		if err := c.Add(resp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := v1.PodSpec{
+		Containers: []v1.Container{
+			{Name: "work", Image: "work", Env: []v1.EnvVar{{Name: "foo", Value: "bar"}}},
+			{Name: "sidecar", Image: "sidecar"},
+		},
+	}
+
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "work"}}
+	job.Spec.Template.Spec = podSpec()
+	if err := c.Apply(job); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expected, job.Spec.Template.Spec); diff != "" {
+		t.Errorf("unexpected job pod spec (-want +got):\n%s", diff)
+	}
+
+	cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "work"}}
+	cronJob.Spec.JobTemplate.Spec.Template.Spec = podSpec()
+	if err := c.Apply(cronJob); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expected, cronJob.Spec.JobTemplate.Spec.Template.Spec); diff != "" {
+		t.Errorf("unexpected cronjob pod spec (-want +got):\n%s", diff)
+	}
+}
+
+func TestChangeset_ApplyUnsupportedKind(t *testing.T) {
+	c := &Changeset{}
+	if err := c.Add(respKeyValue("foo", "bar")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Apply(&v1.ConfigMap{}); err == nil {
+		t.Error("expected error for unsupported kind")
+	}
+}
+
 func respKeyValue(name, value string) *protogen.Response {
 	return &protogen.Response{
 		OFResponse: &protogen.Response_Env{
@@ -191,6 +408,29 @@ func respContainer(c *protogen.Container) *protogen.Response {
 	}
 }
 
+func respVolume(v *protogen.Volume) *protogen.Response {
+	return &protogen.Response{
+		OFResponse: &protogen.Response_Volume{
+			Volume: v,
+		},
+	}
+}
+
+func respVolumeMount(name, path string) *protogen.Response {
+	return &protogen.Response{
+		OFResponse: &protogen.Response_VolumeMount{
+			VolumeMount: &protogen.VolumeMount{Name: name, MountPath: path, ReadOnly: true},
+		},
+	}
+}
+
+func secretVolume(name, secret string) *protogen.Volume {
+	return &protogen.Volume{
+		Name:   name,
+		Secret: &protogen.SecretVolumeSource{SecretName: secret},
+	}
+}
+
 func container(name, image string) *protogen.Container {
 	return &protogen.Container{
 		Name:  name,
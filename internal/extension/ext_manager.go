@@ -16,13 +16,20 @@ import (
 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"google.golang.org/grpc"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type (
 	KeyValue   map[string]any
 	Option     func(*ExtensionManager)
 	WASILoader func(ctx context.Context, image, tag string) (map[string][]byte, error)
+	// HealthNotifier is called when the health of a gRPC extension changes.
+	HealthNotifier func(name string, healthy bool)
+	// NamespaceLabels returns the labels of the namespace with the given name.
+	NamespaceLabels func(ctx context.Context, namespace string) (map[string]string, error)
 )
 
 func WithWASILoader(loader WASILoader) Option {
@@ -37,11 +44,57 @@ func WithGRPCOptions(opts ...grpc.DialOption) Option {
 	}
 }
 
+// WithHealthNotifier sets the function called when the health of a gRPC extension changes.
+func WithHealthNotifier(notifier HealthNotifier) Option {
+	return func(mgr *ExtensionManager) {
+		mgr.healthNotifier = notifier
+	}
+}
+
+// WithHealthCheckInterval sets how often gRPC extensions are health checked,
+// and the maximum delay between checks while an extension is unavailable.
+func WithHealthCheckInterval(interval, maxBackoff time.Duration) Option {
+	return func(mgr *ExtensionManager) {
+		mgr.healthInterval = interval
+		mgr.healthMaxBackoff = maxBackoff
+	}
+}
+
+// WithWASIFetchTimeout sets the maximum duration of fetching a WASI extension.
+func WithWASIFetchTimeout(timeout time.Duration) Option {
+	return func(mgr *ExtensionManager) {
+		mgr.wasiFetchTimeout = timeout
+	}
+}
+
+// WithWASIConfig sets the default and maximum limits of WASI extensions.
+func WithWASIConfig(cfg suffiksv1.WASIConfig) Option {
+	return func(mgr *ExtensionManager) {
+		mgr.wasiConfig = cfg
+	}
+}
+
+// WithNamespaceLabels sets the function used to look up namespace labels
+// when matching the namespace selector of extensions.
+func WithNamespaceLabels(f NamespaceLabels) Option {
+	return func(mgr *ExtensionManager) {
+		mgr.namespaceLabels = f
+	}
+}
+
 type ExtensionManager struct {
-	grpcOptions    []grpc.DialOption
-	wasiController *waruntime.Controller
-	wasiLoader     WASILoader
-	dynamicClient  dynamic.Interface
+	ctx              context.Context
+	grpcOptions      []grpc.DialOption
+	healthNotifier   HealthNotifier
+	healthInterval   time.Duration
+	healthMaxBackoff time.Duration
+	namespaceLabels  NamespaceLabels
+
+	wasiController   *waruntime.Controller
+	wasiLoader       WASILoader
+	wasiFetchTimeout time.Duration
+	wasiConfig       suffiksv1.WASIConfig
+	dynamicClient    dynamic.Interface
 
 	specLock sync.Mutex
 	spec     map[suffiksv1.Target]*specgen.Generator
@@ -53,9 +106,14 @@ type ExtensionManager struct {
 // NewExtensionManager creates a new ExtensionManager. It reads all .yaml files from the provided fs.FS as base types.
 func NewExtensionManager(ctx context.Context, files fs.FS, dynClient dynamic.Interface, opts ...Option) (*ExtensionManager, error) {
 	mgr := &ExtensionManager{
-		wasiController: waruntime.New(ctx),
-		wasiLoader:     oci.Get,
-		dynamicClient:  dynClient,
+		ctx:              ctx,
+		healthInterval:   10 * time.Second,
+		healthMaxBackoff: time.Minute,
+
+		wasiController:   waruntime.New(ctx),
+		wasiLoader:       oci.Get,
+		wasiFetchTimeout: 10 * time.Second,
+		dynamicClient:    dynClient,
 
 		spec:       map[suffiksv1.Target]*specgen.Generator{},
 		extensions: map[string]Extension{},
@@ -111,13 +169,6 @@ func (c *ExtensionManager) add(ext suffiksv1.Extension, target suffiksv1.Target)
 }
 
 func (c *ExtensionManager) addGRPC(ext suffiksv1.Extension, target suffiksv1.Target) error {
-	gclient, err := grpc.Dial(ext.Spec.Controller.GRPC.Target(), c.grpcOptions...)
-	if err != nil {
-		return fmt.Errorf("ExtensionManager.add: grpc dial error: %w", err)
-	}
-
-	client := protogen.NewExtensionClient(gclient)
-
 	c.specLock.Lock()
 	defer c.specLock.Unlock()
 	g, ok := c.spec[target]
@@ -133,25 +184,65 @@ func (c *ExtensionManager) addGRPC(ext suffiksv1.Extension, target suffiksv1.Tar
 	c.rwlock.Lock()
 	defer c.rwlock.Unlock()
 
+	// Keep the connection, and its health monitor, while the target is unchanged.
+	// The previous connection is only closed once the new extension is installed.
+	var conn *grpcConn
+	prev, hasPrev := c.extensions[ext.Name].(*GRPC)
+	if hasPrev && prev.conn.target == ext.Spec.Controller.GRPC.Target() {
+		conn = prev.conn
+	}
+
+	dialed := conn == nil
+	if dialed {
+		var err error
+		conn, err = newGRPCConn(ext.Spec.Controller.GRPC.Target(), c.grpcOptions...)
+		if err != nil {
+			return fmt.Errorf("ExtensionManager.add: grpc dial error: %w", err)
+		}
+		conn.monitor(c.ctx, ext.Name, c.healthInterval, c.healthMaxBackoff, c.healthNotifier)
+	}
+
 	wext := &GRPC{
 		Extension: ext,
-		client:    client,
-		gclient:   gclient,
+		client:    protogen.NewExtensionClient(conn.gclient),
+		conn:      conn,
 	}
 	if err := wext.init(); err != nil {
+		if dialed {
+			_ = conn.close()
+		}
 		return err
 	}
 	c.extensions[ext.Name] = wext
 
+	if hasPrev && dialed {
+		if err := prev.Close(context.Background()); err != nil {
+			return fmt.Errorf("ExtensionManager.add: grpc close error: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (c *ExtensionManager) addWASI(ext suffiksv1.Extension, target suffiksv1.Target) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	files, err := c.wasiLoader(ctx, ext.Spec.Controller.WASI.Image, ext.Spec.Controller.WASI.Tag)
-	if err != nil {
-		return fmt.Errorf("ExtensionManager.add: oci get error: %w", err)
+	c.rwlock.RLock()
+	prev, loaded := c.extensions[ext.Name].(*WASI)
+	c.rwlock.RUnlock()
+
+	// Only fetch the module when the image or tag changed, the running module
+	// is reused otherwise.
+	loaded = loaded && prev.Version() == ext.Spec.Controller.WASI.ImageTag()
+
+	var files map[string][]byte
+	if !loaded {
+		ctx, cancel := context.WithTimeout(context.Background(), c.wasiFetchTimeout)
+		defer cancel()
+
+		var err error
+		files, err = c.wasiLoader(ctx, ext.Spec.Controller.WASI.Image, ext.Spec.Controller.WASI.Tag)
+		if err != nil {
+			return fmt.Errorf("ExtensionManager.add: oci get error: %w", err)
+		}
 	}
 
 	c.specLock.Lock()
@@ -174,7 +265,12 @@ func (c *ExtensionManager) addWASI(ext suffiksv1.Extension, target suffiksv1.Tar
 		c.wasiController,
 		c.dynamicClient,
 	)
-	if err := wext.init(files); err != nil {
+	wext.config = c.wasiConfig
+	if loaded {
+		if err := wext.update(prev); err != nil {
+			return err
+		}
+	} else if err := wext.init(files); err != nil {
 		return err
 	}
 	c.extensions[ext.Name] = wext
@@ -182,6 +278,29 @@ func (c *ExtensionManager) addWASI(ext suffiksv1.Extension, target suffiksv1.Tar
 	return nil
 }
 
+// Healthy reports whether the extension is able to serve requests.
+// Extensions without health checks are always healthy.
+func (c *ExtensionManager) Healthy(name string) bool {
+	c.rwlock.RLock()
+	defer c.rwlock.RUnlock()
+
+	ext, ok := c.extensions[name].(HealthChecker)
+	return !ok || ext.Healthy()
+}
+
+// Version returns the loaded version of a WASI extension.
+// An empty string is returned for unknown and gRPC extensions.
+func (c *ExtensionManager) Version(name string) string {
+	c.rwlock.RLock()
+	defer c.rwlock.RUnlock()
+
+	ext, ok := c.extensions[name].(*WASI)
+	if !ok {
+		return ""
+	}
+	return ext.Version()
+}
+
 func (c *ExtensionManager) Remove(ext *suffiksv1.Extension) error {
 	c.specLock.Lock()
 	defer c.specLock.Unlock()
@@ -196,6 +315,14 @@ func (c *ExtensionManager) Remove(ext *suffiksv1.Extension) error {
 		}
 	}
 
+	c.rwlock.Lock()
+	defer c.rwlock.Unlock()
+
+	if g, ok := c.extensions[ext.Name].(*GRPC); ok {
+		if err := g.Close(context.Background()); err != nil {
+			return err
+		}
+	}
 	delete(c.extensions, ext.Name)
 
 	return nil
@@ -212,7 +339,40 @@ func (c *ExtensionManager) Schema(target suffiksv1.Target) *apiextv1.JSONSchemaP
 	return g.Schema()
 }
 
-func (c *ExtensionManager) ExtensionsFor(kind string) []Extension {
+// ExtensionsFor returns the extensions targeting the kind of obj, with a
+// namespace and object selector matching obj.
+func (c *ExtensionManager) ExtensionsFor(ctx context.Context, obj client.Object) ([]Extension, error) {
+	var (
+		nsLabels labels.Set
+		lookedUp bool
+	)
+
+	cp := []Extension{}
+	for _, ext := range c.ExtensionsForKind(obj.GetObjectKind().GroupVersionKind().Kind) {
+		spec := ext.Spec()
+		if spec.NamespaceSelector != nil && !lookedUp && c.namespaceLabels != nil {
+			// Only look up the namespace once, and only when an extension needs it.
+			l, err := c.namespaceLabels(ctx, obj.GetNamespace())
+			if err != nil {
+				return nil, fmt.Errorf("unable to get labels of namespace %q: %w", obj.GetNamespace(), err)
+			}
+			nsLabels, lookedUp = l, true
+		}
+
+		ok, err := selects(spec, labels.Set(obj.GetLabels()), nsLabels)
+		if err != nil {
+			return nil, fmt.Errorf("extension %q: %w", ext.Name(), err)
+		}
+		if ok {
+			cp = append(cp, ext)
+		}
+	}
+
+	return cp, nil
+}
+
+// ExtensionsForKind returns every extension targeting kind, regardless of selectors.
+func (c *ExtensionManager) ExtensionsForKind(kind string) []Extension {
 	c.rwlock.RLock()
 	defer c.rwlock.RUnlock()
 
@@ -226,6 +386,27 @@ func (c *ExtensionManager) ExtensionsFor(kind string) []Extension {
 	return cp
 }
 
+// selects reports whether the object and namespace selectors of spec match the given labels.
+func selects(spec suffiksv1.ExtensionSpec, objLabels, nsLabels labels.Set) (bool, error) {
+	ok, err := matchesSelector(spec.ObjectSelector, objLabels)
+	if err != nil || !ok {
+		return false, err
+	}
+	return matchesSelector(spec.NamespaceSelector, nsLabels)
+}
+
+// matchesSelector reports whether l matches sel. A nil selector matches everything.
+func matchesSelector(sel *metav1.LabelSelector, l labels.Set) (bool, error) {
+	if sel == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(l), nil
+}
+
 func (c *ExtensionManager) All() []Extension {
 	c.rwlock.RLock()
 	defer c.rwlock.RUnlock()
@@ -6,14 +6,19 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/suffiks/suffiks/extension/protogen"
+	"github.com/suffiks/suffiks/internal/extension/oci"
 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/test/bufconn"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -68,11 +73,11 @@ func TestNewExtensionManager(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if len(mgr.ExtensionsFor("Application")) != 1 {
+	if len(mgr.ExtensionsForKind("Application")) != 1 {
 		t.Error("Expected 1 extension for Application")
 	}
 
-	if len(mgr.ExtensionsFor("Work")) != 0 {
+	if len(mgr.ExtensionsForKind("Work")) != 0 {
 		t.Error("Expected 0 extension for Work")
 	}
 
@@ -80,17 +85,237 @@ func TestNewExtensionManager(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if len(mgr.ExtensionsFor("Application")) != 0 {
+	if len(mgr.ExtensionsForKind("Application")) != 0 {
 		t.Error("Expected 0 extension for Application")
 	}
 
-	if len(mgr.ExtensionsFor("Work")) != 0 {
+	if len(mgr.ExtensionsForKind("Work")) != 0 {
 		t.Error("Expected 0 extension for Work")
 	}
 }
 
+func TestExtensionManager_ExtensionsFor(t *testing.T) {
+	ext := func(name string, nsSel, objSel *metav1.LabelSelector) Extension {
+		return &GRPC{Extension: suffiksv1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: suffiksv1.ExtensionSpec{
+				Targets:           []suffiksv1.Target{"Application"},
+				NamespaceSelector: nsSel,
+				ObjectSelector:    objSel,
+			},
+		}}
+	}
+	mesh := &metav1.LabelSelector{MatchLabels: map[string]string{"mesh": "enabled"}}
+	teamA := &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+		{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"a"}},
+	}}
+
+	namespaces := map[string]map[string]string{
+		"meshed": {"mesh": "enabled"},
+		"plain":  {},
+	}
+	lookups := 0
+	mgr := &ExtensionManager{
+		namespaceLabels: func(_ context.Context, name string) (map[string]string, error) {
+			lookups++
+			return namespaces[name], nil
+		},
+		extensions: map[string]Extension{
+			"all":     ext("all", nil, nil),
+			"mesh":    ext("mesh", mesh, nil),
+			"team-a":  ext("team-a", nil, teamA),
+			"both":    ext("both", mesh, teamA),
+			"mesh-ns": ext("mesh-ns", mesh, nil),
+		},
+	}
+
+	tests := map[string]struct {
+		namespace string
+		labels    map[string]string
+		want      []string
+	}{
+		"plain namespace": {
+			namespace: "plain",
+			want:      []string{"all"},
+		},
+		"meshed namespace": {
+			namespace: "meshed",
+			want:      []string{"all", "mesh", "mesh-ns"},
+		},
+		"object labels": {
+			namespace: "plain",
+			labels:    map[string]string{"team": "a"},
+			want:      []string{"all", "team-a"},
+		},
+		"both": {
+			namespace: "meshed",
+			labels:    map[string]string{"team": "a"},
+			want:      []string{"all", "both", "mesh", "mesh-ns", "team-a"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			lookups = 0
+			app := &suffiksv1.Application{
+				TypeMeta:   metav1.TypeMeta{Kind: "Application"},
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: tc.namespace, Labels: tc.labels},
+			}
+
+			exts, err := mgr.ExtensionsFor(context.Background(), app)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := []string{}
+			for _, ext := range exts {
+				got = append(got, ext.Name())
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("ExtensionsFor() mismatch (-want +got):\n%s", diff)
+			}
+			if lookups > 1 {
+				t.Errorf("namespace looked up %d times, want at most once", lookups)
+			}
+		})
+	}
+}
+
+func TestExtensionManager_AddWASI(t *testing.T) {
+	module, err := os.ReadFile("../waruntime/testdata/as/build/release.wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetched := []string{}
+	loader := func(ctx context.Context, image, tag string) (map[string][]byte, error) {
+		fetched = append(fetched, image+":"+tag)
+		return map[string][]byte{oci.MediaTypeWASI: module}, nil
+	}
+
+	mgr, err := NewExtensionManager(context.Background(), os.DirFS("./testdata"), nil, WithWASILoader(loader))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ext := suffiksv1.Extension{
+		Spec: suffiksv1.ExtensionSpec{
+			Targets: []suffiksv1.Target{"Application", "Work"},
+			Controller: suffiksv1.ControllerSpec{
+				WASI: &suffiksv1.ExtensionWASIController{
+					Image: "ghcr.io/suffiks/ingress",
+					Tag:   "v1",
+				},
+			},
+			OpenAPIV3Schema: runtime.RawExtension{
+				Raw: []byte(`{"type":"object","properties":{"ingresses":{"type":"array"}}}`),
+			},
+		},
+	}
+	ext.Name = "ingress"
+
+	// Reconciling an unchanged extension must not fetch the module again.
+	for i := 0; i < 2; i++ {
+		if err := mgr.Add(ext); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if v := mgr.Version("ingress"); v != "ghcr.io/suffiks/ingress:v1" {
+		t.Errorf("unexpected version %q", v)
+	}
+
+	ext.Spec.Controller.WASI.Tag = "v2"
+	if err := mgr.Add(ext); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := mgr.Version("ingress"); v != "ghcr.io/suffiks/ingress:v2" {
+		t.Errorf("unexpected version %q", v)
+	}
+
+	expected := []string{"ghcr.io/suffiks/ingress:v1", "ghcr.io/suffiks/ingress:v2"}
+	if diff := cmp.Diff(expected, fetched); diff != "" {
+		t.Errorf("fetched modules diff -want +got\n%s", diff)
+	}
+}
+
+func TestExtensionManager_GRPCHealth(t *testing.T) {
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	listener := &mockGRPCListener{
+		Server:   &protogen.UnimplementedExtensionServer{},
+		Register: func(s *grpc.Server) { healthpb.RegisterHealthServer(s, healthSrv) },
+	}
+	defer listener.Stop()
+
+	changes := make(chan bool, 10)
+	mgr, err := NewExtensionManager(
+		context.Background(),
+		os.DirFS("./testdata"),
+		nil,
+		WithGRPCOptions(
+			grpc.WithContextDialer(listener.Dialer),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		),
+		WithHealthCheckInterval(10*time.Millisecond, 20*time.Millisecond),
+		WithHealthNotifier(func(name string, healthy bool) {
+			changes <- healthy
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ext := suffiksv1.Extension{
+		Spec: suffiksv1.ExtensionSpec{
+			Targets: []suffiksv1.Target{"Application"},
+			Controller: suffiksv1.ControllerSpec{
+				GRPC: &suffiksv1.ExtensionGRPCController{Service: "ext", Namespace: "default", Port: 1234},
+			},
+			OpenAPIV3Schema: runtime.RawExtension{
+				Raw: []byte(`{"type":"object","properties":{"foo":{"type":"string"}}}`),
+			},
+		},
+	}
+	ext.Name = "health"
+
+	// Re-adding an extension with the same target must keep the connection.
+	for i := 0; i < 2; i++ {
+		if err := mgr.Add(ext); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func() { _ = mgr.Remove(&ext) }()
+
+	waitFor := func(expected bool) {
+		t.Helper()
+		select {
+		case healthy := <-changes:
+			if healthy != expected {
+				t.Fatalf("expected healthy %v, got %v", expected, healthy)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for healthy %v", expected)
+		}
+		if mgr.Healthy("health") != expected {
+			t.Fatalf("expected Healthy to return %v", expected)
+		}
+	}
+
+	waitFor(false)
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	waitFor(true)
+
+	if listener.Dials != 1 {
+		t.Errorf("expected 1 dial, got %d", listener.Dials)
+	}
+}
+
 type mockGRPCListener struct {
-	Server protogen.ExtensionServer
+	Server   protogen.ExtensionServer
+	Register func(*grpc.Server)
 
 	Dials      int
 	listener   *bufconn.Listener
@@ -116,7 +341,12 @@ func (m *mockGRPCListener) init() {
 	m.listener = bufconn.Listen(1024 * 1024)
 	m.grpcServer = grpc.NewServer()
 	protogen.RegisterExtensionServer(m.grpcServer, m.Server)
-	if err := m.grpcServer.Serve(m.listener); err != nil {
-		panic(err)
+	if m.Register != nil {
+		m.Register(m.grpcServer)
 	}
+	go func() {
+		if err := m.grpcServer.Serve(m.listener); err != nil {
+			panic(err)
+		}
+	}()
 }
@@ -2,11 +2,21 @@ package extension
 
 import (
 	"context"
+	"errors"
 
 	"github.com/suffiks/suffiks/extension/protogen"
 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 )
 
+// ErrUnavailable is returned when an extension is unable to serve requests.
+var ErrUnavailable = errors.New("extension unavailable")
+
+// HealthChecker is implemented by extensions that might be unavailable,
+// such as extensions running in a separate service.
+type HealthChecker interface {
+	Healthy() bool
+}
+
 type StreamResponse interface {
 	Recv() (*protogen.Response, error)
 }
@@ -3,10 +3,15 @@ package extension
 import (
 	"context"
 	"encoding/json"
+	"sync/atomic"
+	"time"
 
 	"github.com/suffiks/suffiks/extension/protogen"
 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 type GRPC struct {
@@ -14,12 +19,15 @@ type GRPC struct {
 
 	sourceSpec []string
 	client     protogen.ExtensionClient
-	gclient    *grpc.ClientConn
+	conn       *grpcConn
 }
 
 func (g *GRPC) Name() string                  { return g.Extension.Name }
 func (g *GRPC) Spec() suffiksv1.ExtensionSpec { return g.Extension.Spec }
-func (g *GRPC) Close(context.Context) error   { return g.gclient.Close() }
+func (g *GRPC) Close(context.Context) error   { return g.conn.close() }
+
+// Healthy reports whether the last health check of the extension succeeded.
+func (g *GRPC) Healthy() bool { return g.conn.healthy.Load() }
 
 func (g *GRPC) Default(ctx context.Context, in *protogen.SyncRequest) (*protogen.DefaultResponse, error) {
 	return g.client.Default(ctx, in)
@@ -56,3 +64,82 @@ func (g *GRPC) init() error {
 	}
 	return nil
 }
+
+// grpcConn is the connection to a gRPC extension. It is shared between
+// versions of the same extension, as long as the target is unchanged.
+type grpcConn struct {
+	target  string
+	gclient *grpc.ClientConn
+	healthy atomic.Bool
+	cancel  context.CancelFunc
+}
+
+func newGRPCConn(target string, opts ...grpc.DialOption) (*grpcConn, error) {
+	gclient, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &grpcConn{
+		target:  target,
+		gclient: gclient,
+		cancel:  func() {},
+	}
+	// Assume the extension is healthy until the first health check says otherwise.
+	conn.healthy.Store(true)
+	return conn, nil
+}
+
+func (c *grpcConn) close() error {
+	c.cancel()
+	return c.gclient.Close()
+}
+
+// monitor runs the gRPC health protocol against the extension until ctx is done.
+// While the extension is unavailable, checks are retried with an exponential
+// backoff and the connection is asked to reconnect.
+// onChange is called every time the health of the extension changes.
+func (c *grpcConn) monitor(ctx context.Context, name string, interval, maxBackoff time.Duration, onChange func(name string, healthy bool)) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	client := healthpb.NewHealthClient(c.gclient)
+
+	go func() {
+		delay := interval
+		for {
+			healthy := c.check(ctx, client, interval)
+			if ctx.Err() != nil {
+				return
+			}
+
+			if c.healthy.Swap(healthy) != healthy && onChange != nil {
+				onChange(name, healthy)
+			}
+
+			if healthy {
+				delay = interval
+			} else {
+				c.gclient.Connect()
+				delay = min(delay*2, maxBackoff)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+		}
+	}()
+}
+
+func (c *grpcConn) check(ctx context.Context, client healthpb.HealthClient, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		// Extensions not implementing the health protocol are reachable,
+		// so they are considered healthy.
+		return status.Code(err) == codes.Unimplemented
+	}
+	return resp.GetStatus() == healthpb.HealthCheckResponse_SERVING
+}
@@ -10,6 +10,9 @@ import (
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/memory"
 	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+	"oras.land/oras-go/v2/registry/remote/retry"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -18,7 +21,44 @@ const (
 	MediaTypeDocs = "application/vnd.com.suffiks.docs.layer.v1+tar"
 )
 
+// Options configures how images are fetched.
+type Options struct {
+	// PlainHTTPRegistries are registries accessed over plain HTTP.
+	PlainHTTPRegistries []string
+	// CredentialsFile is the path to a Docker config file with registry credentials.
+	CredentialsFile string
+}
+
+// Client fetches extension images from OCI registries.
+type Client struct {
+	plainHTTP  map[string]bool
+	credential auth.CredentialFunc
+}
+
+// New creates a Client using opts.
+func New(opts Options) (*Client, error) {
+	c := &Client{plainHTTP: map[string]bool{}}
+	for _, registry := range opts.PlainHTTPRegistries {
+		c.plainHTTP[registry] = true
+	}
+
+	if opts.CredentialsFile != "" {
+		store, err := credentials.NewStore(opts.CredentialsFile, credentials.StoreOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("oci.New: unable to load credentials: %w", err)
+		}
+		c.credential = credentials.Credential(store)
+	}
+	return c, nil
+}
+
+// Get fetches image from a public registry using HTTPS.
 func Get(ctx context.Context, image, tag string) (map[string][]byte, error) {
+	return (&Client{}).Get(ctx, image, tag)
+}
+
+// Get fetches the WASI module and documentation layers of image.
+func (c *Client) Get(ctx context.Context, image, tag string) (map[string][]byte, error) {
 	store := memory.New()
 
 	// 1. Connect to a remote repository
@@ -26,6 +66,14 @@ func Get(ctx context.Context, image, tag string) (map[string][]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("oci.Get: unable to create remote repository: %w", err)
 	}
+	repo.PlainHTTP = c.plainHTTP[repo.Reference.Registry]
+	if c.credential != nil {
+		repo.Client = &auth.Client{
+			Client:     retry.DefaultClient,
+			Cache:      auth.NewCache(),
+			Credential: c.credential,
+		}
+	}
 
 	// 3. Copy from the remote repository to the OCI layout store
 	manifestDescriptor, err := oras.Copy(ctx, repo, tag, store, tag, oras.DefaultCopyOptions)
@@ -0,0 +1,129 @@
+package extension
+
+import (
+	"fmt"
+
+	"github.com/suffiks/suffiks/extension/protogen"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+// volumeFromProto converts a Volume response to a pod volume.
+func volumeFromProto(vol *protogen.Volume) (v1.Volume, error) {
+	if vol.GetName() == "" {
+		return v1.Volume{}, fmt.Errorf("volume name is required")
+	}
+
+	ret := v1.Volume{Name: vol.GetName()}
+	sources := 0
+	if src := vol.GetConfigMap(); src != nil {
+		sources++
+		ret.ConfigMap = &v1.ConfigMapVolumeSource{
+			LocalObjectReference: v1.LocalObjectReference{Name: src.GetName()},
+			Items:                keyToPaths(src.GetItems()),
+			DefaultMode:          src.DefaultMode,
+			Optional:             optional(src.GetOptional()),
+		}
+	}
+	if src := vol.GetSecret(); src != nil {
+		sources++
+		ret.Secret = &v1.SecretVolumeSource{
+			SecretName:  src.GetSecretName(),
+			Items:       keyToPaths(src.GetItems()),
+			DefaultMode: src.DefaultMode,
+			Optional:    optional(src.GetOptional()),
+		}
+	}
+	if src := vol.GetEmptyDir(); src != nil {
+		sources++
+		ret.EmptyDir = &v1.EmptyDirVolumeSource{Medium: v1.StorageMedium(src.GetMedium())}
+		if s := src.GetSizeLimit().GetString_(); s != "" {
+			q, err := resource.ParseQuantity(s)
+			if err != nil {
+				return v1.Volume{}, fmt.Errorf("volume %q: sizeLimit: %w", vol.GetName(), err)
+			}
+			ret.EmptyDir.SizeLimit = &q
+		}
+	}
+	if src := vol.GetProjected(); src != nil {
+		sources++
+		ret.Projected = &v1.ProjectedVolumeSource{DefaultMode: src.DefaultMode}
+		for _, p := range src.GetSources() {
+			proj := v1.VolumeProjection{}
+			if cm := p.GetConfigMap(); cm != nil {
+				proj.ConfigMap = &v1.ConfigMapProjection{
+					LocalObjectReference: v1.LocalObjectReference{Name: cm.GetName()},
+					Items:                keyToPaths(cm.GetItems()),
+					Optional:             optional(cm.GetOptional()),
+				}
+			}
+			if sec := p.GetSecret(); sec != nil {
+				proj.Secret = &v1.SecretProjection{
+					LocalObjectReference: v1.LocalObjectReference{Name: sec.GetName()},
+					Items:                keyToPaths(sec.GetItems()),
+					Optional:             optional(sec.GetOptional()),
+				}
+			}
+			if sat := p.GetServiceAccountToken(); sat != nil {
+				proj.ServiceAccountToken = &v1.ServiceAccountTokenProjection{
+					Audience: sat.GetAudience(),
+					Path:     sat.GetPath(),
+				}
+				if sat.GetExpirationSeconds() > 0 {
+					proj.ServiceAccountToken.ExpirationSeconds = ptr.To(sat.GetExpirationSeconds())
+				}
+			}
+			ret.Projected.Sources = append(ret.Projected.Sources, proj)
+		}
+	}
+	if src := vol.GetPersistentVolumeClaim(); src != nil {
+		sources++
+		ret.PersistentVolumeClaim = &v1.PersistentVolumeClaimVolumeSource{
+			ClaimName: src.GetClaimName(),
+			ReadOnly:  src.GetReadOnly(),
+		}
+	}
+
+	if sources != 1 {
+		return v1.Volume{}, fmt.Errorf("volume %q must have exactly one source, got %d", vol.GetName(), sources)
+	}
+	return ret, nil
+}
+
+// volumeMountFromProto converts a VolumeMount response to a container volume mount.
+func volumeMountFromProto(mount *protogen.VolumeMount) (v1.VolumeMount, error) {
+	if mount.GetName() == "" || mount.GetMountPath() == "" {
+		return v1.VolumeMount{}, fmt.Errorf("volume mount requires a name and mount path")
+	}
+
+	return v1.VolumeMount{
+		Name:      mount.GetName(),
+		ReadOnly:  mount.GetReadOnly(),
+		MountPath: mount.GetMountPath(),
+		SubPath:   mount.GetSubPath(),
+	}, nil
+}
+
+func keyToPaths(items []*protogen.KeyToPath) []v1.KeyToPath {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ret := make([]v1.KeyToPath, 0, len(items))
+	for _, item := range items {
+		ret = append(ret, v1.KeyToPath{
+			Key:  item.GetKey(),
+			Path: item.GetPath(),
+			Mode: item.Mode,
+		})
+	}
+	return ret
+}
+
+func optional(b bool) *bool {
+	if !b {
+		return nil
+	}
+	return ptr.To(true)
+}
@@ -0,0 +1,105 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suffiks/suffiks/extension/protogen"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func TestVolumeFromProto(t *testing.T) {
+	tests := map[string]struct {
+		volume  *protogen.Volume
+		want    v1.Volume
+		wantErr bool
+	}{
+		"configmap": {
+			volume: &protogen.Volume{
+				Name: "config",
+				ConfigMap: &protogen.ConfigMapVolumeSource{
+					Name:        "app-config",
+					Items:       []*protogen.KeyToPath{{Key: "config.yaml", Path: "app.yaml", Mode: ptr.To[int32](0o400)}},
+					DefaultMode: ptr.To[int32](0o444),
+					Optional:    true,
+				},
+			},
+			want: v1.Volume{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: "app-config"},
+				Items:                []v1.KeyToPath{{Key: "config.yaml", Path: "app.yaml", Mode: ptr.To[int32](0o400)}},
+				DefaultMode:          ptr.To[int32](0o444),
+				Optional:             ptr.To(true),
+			}}},
+		},
+		"empty dir": {
+			volume: &protogen.Volume{
+				Name:     "cache",
+				EmptyDir: &protogen.EmptyDirVolumeSource{Medium: "Memory", SizeLimit: &protogen.Quantity{String_: ptr.To("64Mi")}},
+			},
+			want: v1.Volume{Name: "cache", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{
+				Medium:    v1.StorageMediumMemory,
+				SizeLimit: ptr.To(resource.MustParse("64Mi")),
+			}}},
+		},
+		"projected": {
+			volume: &protogen.Volume{
+				Name: "token",
+				Projected: &protogen.ProjectedVolumeSource{Sources: []*protogen.VolumeProjection{
+					{ServiceAccountToken: &protogen.ServiceAccountTokenProjection{Audience: "vault", ExpirationSeconds: 600, Path: "token"}},
+					{Secret: &protogen.SecretProjection{Name: "ca"}},
+				}},
+			},
+			want: v1.Volume{Name: "token", VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{Sources: []v1.VolumeProjection{
+				{ServiceAccountToken: &v1.ServiceAccountTokenProjection{Audience: "vault", ExpirationSeconds: ptr.To[int64](600), Path: "token"}},
+				{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "ca"}}},
+			}}}},
+		},
+		"pvc": {
+			volume: &protogen.Volume{
+				Name:                  "data",
+				PersistentVolumeClaim: &protogen.PersistentVolumeClaimVolumeSource{ClaimName: "data", ReadOnly: true},
+			},
+			want: v1.Volume{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "data", ReadOnly: true}}},
+		},
+		"no source": {
+			volume:  &protogen.Volume{Name: "data"},
+			wantErr: true,
+		},
+		"multiple sources": {
+			volume: &protogen.Volume{
+				Name:     "data",
+				EmptyDir: &protogen.EmptyDirVolumeSource{},
+				Secret:   &protogen.SecretVolumeSource{SecretName: "data"},
+			},
+			wantErr: true,
+		},
+		"invalid size limit": {
+			volume: &protogen.Volume{
+				Name:     "cache",
+				EmptyDir: &protogen.EmptyDirVolumeSource{SizeLimit: &protogen.Quantity{String_: ptr.To("lots")}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := volumeFromProto(tc.volume)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
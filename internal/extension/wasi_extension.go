@@ -22,6 +22,7 @@ type WASI struct {
 	dynamicClient dynamic.Interface
 
 	controller *waruntime.Controller
+	config     suffiksv1.WASIConfig
 	pages      [][]byte
 
 	sourceSpec []string
@@ -61,14 +62,12 @@ func (w *WASI) Validate(ctx context.Context, in *protogen.ValidationRequest) (*p
 		return nil, fmt.Errorf("WASI.Validate: error creating new runner: %w", err)
 	}
 
-	errs, err := w.instance.Validate(ctx, in)
+	resp, err := w.instance.Validate(ctx, in)
 	if err != nil {
 		return nil, fmt.Errorf("WASI.Validate: error validating: %w", err)
 	}
 
-	return &protogen.ValidationResponse{
-		Errors: errs,
-	}, nil
+	return resp, nil
 }
 
 func (w *WASI) Sync(ctx context.Context, in *protogen.SyncRequest) (StreamResponse, error) {
@@ -97,7 +96,34 @@ func (w *WASI) Documentation(ctx context.Context) (*protogen.DocumentationRespon
 	}, nil
 }
 
+// Version returns the version of the loaded WASI module.
+func (w *WASI) Version() string {
+	v, _ := w.controller.Version(w.Name())
+	return v
+}
+
 func (w *WASI) init(files map[string][]byte) error {
+	if err := w.load(files[oci.MediaTypeWASI]); err != nil {
+		return fmt.Errorf("WASI.init: error loading wasi module: %w", err)
+	}
+
+	if err := w.initDocs(files); err != nil {
+		return fmt.Errorf("WASI.init: error loading docs: %w", err)
+	}
+	return nil
+}
+
+// update reuses the module and documentation loaded by prev, which must have the same image tag.
+func (w *WASI) update(prev *WASI) error {
+	if err := w.load(nil); err != nil {
+		return fmt.Errorf("WASI.update: error updating wasi module: %w", err)
+	}
+
+	w.pages = prev.pages
+	return nil
+}
+
+func (w *WASI) load(module []byte) error {
 	props := &properties{}
 	if err := json.Unmarshal(w.Spec().OpenAPIV3Schema.Raw, props); err != nil {
 		return err
@@ -106,22 +132,59 @@ func (w *WASI) init(files map[string][]byte) error {
 		w.sourceSpec = append(w.sourceSpec, key)
 	}
 
-	permissions := make(map[string]struct{})
+	permissions := make(map[string]waruntime.Permission)
 	for _, perm := range w.Spec().Controller.WASI.Resources {
 		for _, method := range perm.Methods {
-			permissions[perm.String()+"."+string(method)] = struct{}{}
+			permissions[perm.String()+"."+string(method)] = waruntime.Permission{
+				Cluster:    perm.Scope == suffiksv1.ResourceScopeCluster,
+				Namespaces: perm.Namespaces,
+			}
 		}
 	}
 
-	err := w.controller.Load(context.Background(), w.Name(), w.Spec().Controller.WASI.ImageTag(), files[oci.MediaTypeWASI], permissions, w.Spec().Controller.WASI.ConfigMap)
-	if err != nil {
-		return fmt.Errorf("WASI.init: error loading wasi module: %w", err)
+	return w.controller.Load(context.Background(), w.Name(), w.Spec().Controller.WASI.ImageTag(), module, permissions, w.Spec().Controller.WASI.ConfigMap, w.limits())
+}
+
+// defaultMaxOutputBytes is the number of bytes of stdout and stderr logged for
+// each call when not configured.
+const defaultMaxOutputBytes = 16384
+
+// limits returns the limits of the extension. Limits not set by the extension
+// use the configured defaults, and are capped by the configured maximums.
+func (w *WASI) limits() waruntime.Limits {
+	limits := applyWASILimits(waruntime.Limits{CloseOnContextDone: true, MaxOutputBytes: defaultMaxOutputBytes}, w.config.DefaultLimits)
+	if w.config.MaxOutputBytes > 0 {
+		limits.MaxOutputBytes = w.config.MaxOutputBytes
 	}
+	limits = applyWASILimits(limits, w.Spec().Controller.WASI.Limits)
 
-	if err := w.initDocs(files); err != nil {
-		return fmt.Errorf("WASI.init: error loading docs: %w", err)
+	if max := w.config.MaxMemoryPages; max > 0 && (limits.MaxMemoryPages == 0 || limits.MaxMemoryPages > max) {
+		limits.MaxMemoryPages = max
 	}
-	return nil
+	if w.config.MaxTimeout != nil {
+		if max := w.config.MaxTimeout.Duration; limits.Timeout == 0 || limits.Timeout > max {
+			limits.Timeout = max
+		}
+	}
+	return limits
+}
+
+// applyWASILimits overrides limits with the fields set in spec.
+func applyWASILimits(limits waruntime.Limits, spec *suffiksv1.ExtensionWASILimits) waruntime.Limits {
+	if spec == nil {
+		return limits
+	}
+
+	if spec.MaxMemoryPages > 0 {
+		limits.MaxMemoryPages = spec.MaxMemoryPages
+	}
+	if spec.Timeout != nil {
+		limits.Timeout = spec.Timeout.Duration
+	}
+	if spec.CloseOnContextDone != nil {
+		limits.CloseOnContextDone = *spec.CloseOnContextDone
+	}
+	return limits
 }
 
 func (w *WASI) initDocs(files map[string][]byte) error {
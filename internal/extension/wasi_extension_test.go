@@ -1,3 +1,63 @@
 package extension
 
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suffiks/suffiks/internal/waruntime"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
 var _ Extension = &WASI{}
+
+func TestWASI_limits(t *testing.T) {
+	duration := func(d time.Duration) *metav1.Duration { return &metav1.Duration{Duration: d} }
+
+	tests := map[string]struct {
+		config suffiksv1.WASIConfig
+		spec   *suffiksv1.ExtensionWASILimits
+		want   waruntime.Limits
+	}{
+		"no limits": {
+			want: waruntime.Limits{CloseOnContextDone: true, MaxOutputBytes: 16384},
+		},
+		"extension limits": {
+			spec: &suffiksv1.ExtensionWASILimits{MaxMemoryPages: 16, Timeout: duration(time.Second), CloseOnContextDone: ptr.To(false)},
+			want: waruntime.Limits{MaxMemoryPages: 16, Timeout: time.Second, MaxOutputBytes: 16384},
+		},
+		"defaults": {
+			config: suffiksv1.WASIConfig{DefaultLimits: &suffiksv1.ExtensionWASILimits{MaxMemoryPages: 32, Timeout: duration(5 * time.Second)}},
+			spec:   &suffiksv1.ExtensionWASILimits{Timeout: duration(time.Second)},
+			want:   waruntime.Limits{MaxMemoryPages: 32, Timeout: time.Second, CloseOnContextDone: true, MaxOutputBytes: 16384},
+		},
+		"capped": {
+			config: suffiksv1.WASIConfig{MaxMemoryPages: 64, MaxTimeout: duration(time.Minute)},
+			spec:   &suffiksv1.ExtensionWASILimits{MaxMemoryPages: 128, Timeout: duration(time.Hour)},
+			want:   waruntime.Limits{MaxMemoryPages: 64, Timeout: time.Minute, CloseOnContextDone: true, MaxOutputBytes: 16384},
+		},
+		"output limit": {
+			config: suffiksv1.WASIConfig{MaxOutputBytes: 1024},
+			want:   waruntime.Limits{CloseOnContextDone: true, MaxOutputBytes: 1024},
+		},
+		"unlimited is capped": {
+			config: suffiksv1.WASIConfig{MaxMemoryPages: 64, MaxTimeout: duration(time.Minute)},
+			want:   waruntime.Limits{MaxMemoryPages: 64, Timeout: time.Minute, CloseOnContextDone: true, MaxOutputBytes: 16384},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ext := suffiksv1.Extension{}
+			ext.Spec.Controller.WASI = &suffiksv1.ExtensionWASIController{Limits: tc.spec}
+			w := NewWASI(ext, nil, nil)
+			w.config = tc.config
+
+			if diff := cmp.Diff(tc.want, w.limits()); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package tracing
 
 import (
 	"context"
+	"os"
 	"runtime/debug"
 
 	"github.com/go-logr/logr"
+	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
@@ -25,12 +27,12 @@ var (
 	provider *trace.TracerProvider
 )
 
-func Provider(ctx context.Context, log logr.Logger /*, cfg suffiksv1.TracingConfig */) error {
+func Provider(ctx context.Context, log logr.Logger, cfg suffiksv1.TracingConfig) error {
 	log = log.WithName("otel-proivder")
-	// if !cfg.Enabled() {
-	// 	log.Info("tracing disabled")
-	// 	return nil
-	// }
+	if !cfg.Enabled() && os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" {
+		log.Info("tracing disabled")
+		return nil
+	}
 
 	dirty := true
 	revision := "unknown"
@@ -47,9 +49,12 @@ func Provider(ctx context.Context, log logr.Logger /*, cfg suffiksv1.TracingConf
 	}
 
 	opts := []otlptracegrpc.Option{}
-	// if cfg.OTLPEndpoint != "" {
-	// 	opts = append(opts, otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint))
-	// }
+	if cfg.OTLPEndpoint != "" {
+		opts = append(opts, otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint))
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
 	client := otlptracegrpc.NewClient(opts...)
 	exp, err := otlptrace.New(ctx, client)
 	if err != nil {
@@ -60,9 +65,9 @@ func Provider(ctx context.Context, log logr.Logger /*, cfg suffiksv1.TracingConf
 		semconv.ServiceNameKey.String(name),
 		semconv.ServiceVersionKey.String(revision),
 	}
-	// for k, v := range cfg.Attributes {
-	// 	cfgAttrs = append(cfgAttrs, attribute.String(k, v))
-	// }
+	for k, v := range cfg.Attributes {
+		cfgAttrs = append(cfgAttrs, attribute.String(k, v))
+	}
 
 	if dirty {
 		cfgAttrs = append(cfgAttrs, attribute.Bool("modified", true))
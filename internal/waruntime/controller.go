@@ -1,27 +1,42 @@
 package waruntime
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"sync"
 
 	suffiksv1 "github.com/suffiks/suffiks/pkg/api/suffiks/v1"
 	"github.com/tetratelabs/wazero"
-	"github.com/tetratelabs/wazero/api"
 	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
 	"k8s.io/client-go/dynamic"
 )
 
 type extension struct {
 	version            string
+	checksum           []byte
+	source             []byte
+	limits             Limits
 	module             wazero.CompiledModule
-	clientPermissions  map[string]struct{}
+	clientPermissions  map[string]Permission
 	configMapReference *suffiksv1.ConfigMapReference
 }
 
+// Permission is the access an extension has to a resource with a method.
+type Permission struct {
+	// Cluster is set for cluster scoped resources, which are accessed without a namespace.
+	Cluster bool
+	// Namespaces the resource may be accessed in, in addition to the namespace of the owner.
+	Namespaces []string
+}
+
 type Controller struct {
 	cache wazero.CompilationCache
 
+	// loadLock serializes calls to Load, so two versions of the same
+	// extension are never compiled at the same time.
+	loadLock   sync.Mutex
 	lock       sync.RWMutex
 	extensions map[string]extension
 }
@@ -42,8 +57,7 @@ func (c *Controller) NewRunner(ctx context.Context, extension string, client dyn
 		return nil, fmt.Errorf("%w: %v", ErrExtensionNotFound, extension)
 	}
 
-	runtimeConfig := wazero.NewRuntimeConfig().WithCompilationCache(c.cache).WithCoreFeatures(api.CoreFeaturesV2)
-	r := wazero.NewRuntimeWithConfig(ctx, runtimeConfig)
+	r := wazero.NewRuntimeWithConfig(ctx, ext.limits.runtimeConfig(c.cache))
 	wasi_snapshot_preview1.MustInstantiate(ctx, r)
 
 	return &Runner{
@@ -55,6 +69,7 @@ func (c *Controller) NewRunner(ctx context.Context, extension string, client dyn
 		client:             client,
 		clientPermissions:  ext.clientPermissions,
 		configMapReference: ext.configMapReference,
+		limits:             ext.limits,
 	}, nil
 }
 
@@ -70,14 +85,50 @@ func (c *Controller) getModule(name string) (ext extension, ok bool) {
 	return ext, ok
 }
 
-func (c *Controller) Load(ctx context.Context, name, version string, module []byte, clientPermissions map[string]struct{}, configMapReference *suffiksv1.ConfigMapReference) error {
+// Version returns the version of the loaded module for the extension.
+func (c *Controller) Version(name string) (string, bool) {
 	ext, ok := c.getModule(name)
-	if ok && ext.version == version {
+	return ext.version, ok
+}
+
+// Load compiles and validates the module, and swaps it in for the extension.
+// The previous module is kept until the new one is validated, so a failing
+// upgrade leaves the running version in place.
+// If the version is already loaded, module may be nil. The loaded module is
+// then recompiled only if the limits changed.
+//
+// Compiled code is shared through the compilation cache by module content
+// and termination checks, but not by memory limit. A module is therefore
+// only closed when no loaded extension uses the same code, as closing it
+// removes the code from the cache for every module sharing it.
+func (c *Controller) Load(ctx context.Context, name, version string, module []byte, clientPermissions map[string]Permission, configMapReference *suffiksv1.ConfigMapReference, limits Limits) error {
+	c.loadLock.Lock()
+	defer c.loadLock.Unlock()
+
+	old, ok := c.getModule(name)
+	if ok && old.version == version {
+		if module == nil {
+			module = old.source
+		}
+	}
+
+	// Compiled modules are shared by content, so an identical module published
+	// under a new version must not be recompiled and closed.
+	// Memory limits and termination checks are part of the compiled module.
+	sum := sha256.Sum256(module)
+	if ok && bytes.Equal(old.checksum, sum[:]) && old.limits.compileEqual(limits) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		old.version = version
+		old.limits = limits
+		old.clientPermissions = clientPermissions
+		old.configMapReference = configMapReference
+		c.extensions[name] = old
 		return nil
 	}
 
-	runtimeConfig := wazero.NewRuntimeConfig().WithCompilationCache(c.cache).WithCoreFeatures(api.CoreFeaturesV2)
-	r := wazero.NewRuntimeWithConfig(ctx, runtimeConfig)
+	r := wazero.NewRuntimeWithConfig(ctx, limits.runtimeConfig(c.cache))
 	wasi_snapshot_preview1.MustInstantiate(ctx, r)
 
 	cm, err := r.CompileModule(ctx, module)
@@ -86,25 +137,46 @@ func (c *Controller) Load(ctx context.Context, name, version string, module []by
 	}
 
 	if err := validate(cm); err != nil {
-		cm.Close(ctx)
+		if !c.codeInUse(sum[:], limits) {
+			cm.Close(ctx)
+		}
 		return err
 	}
 
 	c.lock.Lock()
-	defer c.lock.Unlock()
-
-	if ok {
-		if err := c.extensions[name].module.Close(ctx); err != nil {
-			return err
-		}
-	}
-
 	c.extensions[name] = extension{
 		version:            version,
+		checksum:           sum[:],
+		source:             module,
+		limits:             limits,
 		module:             cm,
 		clientPermissions:  clientPermissions,
 		configMapReference: configMapReference,
 	}
+	c.lock.Unlock()
+
+	// Runners created before the swap instantiate the old module on each
+	// call. Once it is closed those calls fail, and are retried by the
+	// next reconcile with a runner using the new module.
+	if ok && !c.codeInUse(old.checksum, old.limits) {
+		if err := old.module.Close(ctx); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
+
+// codeInUse reports whether a loaded extension shares the compiled code of a
+// module with the checksum, compiled with limits.
+func (c *Controller) codeInUse(checksum []byte, limits Limits) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	for _, ext := range c.extensions {
+		if bytes.Equal(ext.checksum, checksum) && ext.limits.CloseOnContextDone == limits.CloseOnContextDone {
+			return true
+		}
+	}
+	return false
+}
@@ -1,12 +1,78 @@
 package waruntime
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"runtime"
 
+	"github.com/tetratelabs/wazero/api"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
-var ErrExtensionNotFound = errors.New("extension not found")
+var (
+	ErrExtensionNotFound = errors.New("extension not found")
+
+	errReadMemory  = errors.New("failed to read memory")
+	errWriteMemory = errors.New("failed to write memory")
+)
+
+// HostError is returned when a host function, or the runner itself, fails
+// while handling a call to the module. This is usually caused by invalid
+// input from the module.
+type HostError struct {
+	Extension string
+	Function  string
+	Err       error
+}
+
+func (e *HostError) Error() string {
+	if e.Function == "" {
+		return fmt.Sprintf("extension %q: host function failed: %v", e.Extension, e.Err)
+	}
+	return fmt.Sprintf("extension %q: %s failed: %v", e.Extension, e.Function, e.Err)
+}
+
+func (e *HostError) Unwrap() error {
+	return e.Err
+}
+
+// hostPanic aborts the current call with a HostError.
+// wazero recovers the panic and returns it as the error of the call.
+func hostPanic(function string, err error) {
+	panic(&HostError{Function: function, Err: err})
+}
+
+// recoverPanic converts a panic in the runner into a HostError stored in err.
+// It must be deferred directly.
+func (r *Runner) recoverPanic(function string, err *error) {
+	if v := recover(); v != nil {
+		*err = &HostError{Extension: r.name, Function: function, Err: fmt.Errorf("panic: %v", v)}
+	}
+}
+
+// callError converts the error returned by a call to the module into
+// a LimitError or HostError when possible.
+func (r *Runner) callError(ctx context.Context, mod api.Module, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var hostErr *HostError
+	if errors.As(err, &hostErr) {
+		hostErr.Extension = r.name
+		return err
+	}
+
+	// Host functions panicking with a runtime error, such as a nil pointer
+	// dereference, are recovered by wazero.
+	var runtimeErr runtime.Error
+	if errors.As(err, &runtimeErr) {
+		return &HostError{Extension: r.name, Err: err}
+	}
+
+	return r.checkLimits(ctx, mod, err)
+}
 
 type ClientError uint32
 
@@ -0,0 +1,100 @@
+package waruntime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// pageSize is the size of a WebAssembly memory page.
+const pageSize = 65536
+
+// Limits restricts the resources available to a single invocation of a module.
+// The zero value means no limits.
+type Limits struct {
+	// MaxMemoryPages is the maximum number of memory pages a module instance can allocate.
+	MaxMemoryPages uint32
+	// Timeout is the maximum duration of a single call to the module.
+	Timeout time.Duration
+	// CloseOnContextDone stops a running call when its context is done.
+	CloseOnContextDone bool
+	// MaxOutputBytes is the maximum number of bytes of stdout and stderr
+	// logged for each call to the module.
+	MaxOutputBytes int
+}
+
+func (l Limits) runtimeConfig(cache wazero.CompilationCache) wazero.RuntimeConfig {
+	cfg := wazero.NewRuntimeConfig().
+		WithCompilationCache(cache).
+		WithCoreFeatures(api.CoreFeaturesV2).
+		WithCloseOnContextDone(l.CloseOnContextDone)
+
+	if l.MaxMemoryPages > 0 {
+		cfg = cfg.WithMemoryLimitPages(l.MaxMemoryPages)
+	}
+	return cfg
+}
+
+// compileEqual reports whether modules compiled with l and o are interchangeable.
+func (l Limits) compileEqual(o Limits) bool {
+	return l.MaxMemoryPages == o.MaxMemoryPages && l.CloseOnContextDone == o.CloseOnContextDone
+}
+
+// callContext returns the context used for a single call to the module.
+func (l Limits) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if l.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeoutCause(ctx, l.Timeout, errCallTimeout)
+}
+
+// LimitKind is the kind of limit exceeded by a module.
+type LimitKind string
+
+const (
+	LimitTimeout LimitKind = "timeout"
+	LimitMemory  LimitKind = "memory"
+)
+
+var errCallTimeout = errors.New("call timeout")
+
+// LimitError is returned when a call to a module exceeds one of its limits.
+type LimitError struct {
+	Extension string
+	Limit     LimitKind
+	Err       error
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("extension %q exceeded %s limit: %v", e.Extension, e.Limit, e.Err)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
+
+// checkLimits converts err to a LimitError when the failed call was caused by
+// one of the limits of the runner.
+func (r *Runner) checkLimits(ctx context.Context, mod api.Module, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(context.Cause(ctx), errCallTimeout) {
+		return &LimitError{Extension: r.name, Limit: LimitTimeout, Err: err}
+	}
+
+	// A failed memory.grow is not an error in itself, but most languages abort
+	// when allocations fail. Treat failures with exhausted memory as violations.
+	if r.limits.MaxMemoryPages > 0 && mod != nil && mod.Memory() != nil {
+		if mod.Memory().Size()/pageSize >= r.limits.MaxMemoryPages {
+			return &LimitError{Extension: r.name, Limit: LimitMemory, Err: err}
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,123 @@
+package waruntime
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/suffiks/suffiks/extension/protogen"
+	"github.com/suffiks/suffiks/internal/tracing"
+	"github.com/tetratelabs/wazero/api"
+	"go.opentelemetry.io/otel/trace"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// logger returns the logger for the current call, with the extension, the
+// owner and the trace ID.
+func (r *Runner) logger(ctx context.Context) logr.Logger {
+	l := logf.FromContext(ctx).WithValues("extension", r.name, "version", r.version)
+	if owner := r.owner(); owner != nil {
+		l = l.WithValues("owner", owner.Kind+"/"+owner.Name, "namespace", owner.Namespace)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		l = l.WithValues("traceID", sc.TraceID().String())
+	}
+	return l
+}
+
+// log writes a log line from the module.
+//
+// `ptr` and `size` are the pointer and size of the serialized
+// LogEntry proto.
+func (r *Runner) log(ctx context.Context, m api.Module, ptr, size uint32) {
+	span := tracing.Get(ctx)
+	span.AddEvent("log")
+
+	entry := unmarshalProto(m, &protogen.LogEntry{}, ptr, size)
+
+	kv := make([]any, 0, len(entry.Values)*2)
+	for _, v := range entry.Values {
+		kv = append(kv, v.Name, v.Value)
+	}
+
+	l := r.logger(ctx)
+	switch entry.Level {
+	case protogen.LogLevel_DEBUG:
+		l.V(1).Info(entry.Message, kv...)
+	case protogen.LogLevel_ERROR:
+		l.Error(nil, entry.Message, kv...)
+	default:
+		l.Info(entry.Message, kv...)
+	}
+}
+
+// clientError logs err and converts it to the ClientError returned to the module.
+func (r *Runner) clientError(ctx context.Context, err error) uint64 {
+	r.logger(ctx).Error(err, "Kubernetes request failed")
+	return uint64(toClientError(err))
+}
+
+// outputWriter logs each line written to stdout or stderr of a module.
+// Output exceeding limit bytes is dropped. A limit of zero means no limit.
+type outputWriter struct {
+	log     logr.Logger
+	stream  string
+	limit   int
+	written int
+	dropped int
+	buf     []byte
+}
+
+func newOutputWriter(log logr.Logger, stream string, limit int) *outputWriter {
+	return &outputWriter{log: log, stream: stream, limit: limit}
+}
+
+func (w *outputWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	if w.limit > 0 && w.written+len(p) > w.limit {
+		keep := max(w.limit-w.written, 0)
+		w.dropped += len(p) - keep
+		p = p[:keep]
+	}
+	w.written += len(p)
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.logLine(w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+	return n, nil
+}
+
+// flush logs the last line if it isn't terminated by a newline, and the
+// amount of output dropped.
+func (w *outputWriter) flush() {
+	if len(w.buf) > 0 {
+		w.logLine(w.buf)
+		w.buf = nil
+	}
+	if w.dropped > 0 {
+		w.log.Info("Output truncated", "stream", w.stream, "limit", w.limit, "droppedBytes", w.dropped)
+		w.dropped = 0
+	}
+}
+
+func (w *outputWriter) logLine(line []byte) {
+	w.log.Info(string(bytes.TrimSuffix(line, []byte("\r"))), "stream", w.stream)
+}
+
+// moduleInstance is a module instance logging the rest of its output when closed.
+type moduleInstance struct {
+	api.Module
+	stdout, stderr *outputWriter
+}
+
+func (m *moduleInstance) Close(ctx context.Context) error {
+	m.stdout.flush()
+	m.stderr.flush()
+	return m.Module.Close(ctx)
+}
@@ -0,0 +1,53 @@
+package waruntime
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOutputWriter(t *testing.T) {
+	tests := map[string]struct {
+		limit  int
+		writes []string
+		want   []string
+	}{
+		"lines": {
+			writes: []string{"first\nsec", "ond\r\n", "third"},
+			want: []string{
+				`"level"=0 "msg"="first" "stream"="stdout"`,
+				`"level"=0 "msg"="second" "stream"="stdout"`,
+				`"level"=0 "msg"="third" "stream"="stdout"`,
+			},
+		},
+		"truncated": {
+			limit:  8,
+			writes: []string{"first\n", "second\n", "third\n"},
+			want: []string{
+				`"level"=0 "msg"="first" "stream"="stdout"`,
+				`"level"=0 "msg"="se" "stream"="stdout"`,
+				`"level"=0 "msg"="Output truncated" "stream"="stdout" "limit"=8 "droppedBytes"=11`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			log := funcr.New(func(prefix, args string) { got = append(got, args) }, funcr.Options{})
+
+			w := newOutputWriter(log, "stdout", tc.limit)
+			for _, s := range tc.writes {
+				if n, err := w.Write([]byte(s)); err != nil || n != len(s) {
+					t.Fatalf("Write(%q) = %d, %v", s, n, err)
+				}
+			}
+			w.flush()
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected log lines (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
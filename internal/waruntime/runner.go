@@ -2,10 +2,10 @@ package waruntime
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"log"
-	"os"
+	"slices"
 	"sync"
 	"unicode"
 
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 )
 
@@ -40,14 +41,18 @@ type Runner struct {
 
 	validationRequest *protogen.ValidationRequest
 	syncRequest       *protogen.SyncRequest
+	// defaulting is set when syncRequest is for a defaulting request.
+	defaulting bool
 
 	client             dynamic.Interface
-	clientPermissions  map[string]struct{}
+	clientPermissions  map[string]Permission
 	configMapReference *suffiksv1.ConfigMapReference
+	limits             Limits
 
 	msgs             chan *protogen.Response
 	lock             sync.Mutex
 	validationErrors []*protogen.ValidationError
+	warnings         []string
 	DeleteResponse   *protogen.DeleteResponse
 }
 
@@ -67,21 +72,31 @@ func (r *Runner) spanAttributes(span trace.Span) {
 // env returns a map of functions that are exposed to the WASI module.
 func (r *Runner) env() map[string]any {
 	return map[string]any{
-		"AddEnv":           r.addEnv,
-		"AddEnvFrom":       r.addEnvFrom,
-		"AddLabel":         r.addLabel,
-		"AddAnnotation":    r.addAnnotation,
-		"AddInitContainer": r.addInitContainer,
-		"AddSidecar":       r.addSidecar,
-		"MergePatch":       r.mergePatch,
-		"ValidationError":  r.validationError,
-		"GetOwner":         r.getOwner,
-		"GetSpec":          r.getSpec,
-		"GetOld":           r.getOld,
-		"CreateResource":   r.createResource,
-		"UpdateResource":   r.updateResource,
-		"DeleteResource":   r.deleteResource,
-		"GetResource":      r.getResource,
+		"AddEnv":            r.addEnv,
+		"AddEnvFrom":        r.addEnvFrom,
+		"AddLabel":          r.addLabel,
+		"AddAnnotation":     r.addAnnotation,
+		"AddInitContainer":  r.addInitContainer,
+		"AddSidecar":        r.addSidecar,
+		"AddVolume":         r.addVolume,
+		"AddVolumeMount":    r.addVolumeMount,
+		"MergePatch":        r.mergePatch,
+		"SetStatus":         r.setStatus,
+		"ValidationError":   r.validationError,
+		"ValidationWarning": r.validationWarning,
+		"GetOwner":          r.getOwner,
+		"GetSpec":           r.getSpec,
+		"GetOld":            r.getOld,
+		"GetOutputs":        r.getOutputs,
+		"CreateResource":    r.createResource,
+		"UpdateResource":    r.updateResource,
+		"DeleteResource":    r.deleteResource,
+		"GetResource":       r.getResource,
+		"ListResources":     r.listResources,
+		"Log":               r.log,
+		"RecordEvent":       r.recordEvent,
+		"PatchResource":     r.patchResource,
+		"ApplyResource":     r.applyResource,
 	}
 }
 
@@ -100,9 +115,12 @@ func (r *Runner) instance(ctx context.Context) (api.Module, error) {
 		return nil, fmt.Errorf("instantiate: %w", err)
 	}
 
+	log := r.logger(ctx)
+	stdout := newOutputWriter(log, "stdout", r.limits.MaxOutputBytes)
+	stderr := newOutputWriter(log, "stderr", r.limits.MaxOutputBytes)
 	cfg := wazero.NewModuleConfig().
-		WithStdout(os.Stdout).
-		WithStderr(os.Stderr)
+		WithStdout(stdout).
+		WithStderr(stderr)
 
 	if r.configMapReference != nil {
 		cmu, err := r.client.Resource(schema.GroupVersionResource{
@@ -126,49 +144,72 @@ func (r *Runner) instance(ctx context.Context) (api.Module, error) {
 		}
 	}
 
-	return r.runtime.InstantiateModule(ctx, r.module, cfg)
+	inst, err := r.runtime.InstantiateModule(ctx, r.module, cfg)
+	if err != nil {
+		stdout.flush()
+		stderr.flush()
+		return nil, err
+	}
+	return &moduleInstance{Module: inst, stdout: stdout, stderr: stderr}, nil
 }
 
-func (r *Runner) Validate(ctx context.Context, req *protogen.ValidationRequest) ([]*protogen.ValidationError, error) {
+func (r *Runner) Validate(ctx context.Context, req *protogen.ValidationRequest) (_ *protogen.ValidationResponse, err error) {
 	ctx, span := tracing.Start(ctx, "WASI.Validate")
 	defer span.End()
+	defer r.recoverPanic("Validate", &err)
 	r.spanAttributes(span)
 
+	ctx, cancel := r.limits.callContext(ctx)
+	defer cancel()
+
+	r.validationRequest = req
 	mod, err := r.instance(ctx)
 	if err != nil {
-		return nil, err
+		return nil, r.callError(ctx, nil, err)
 	}
 	defer mod.Close(ctx)
 
-	r.validationRequest = req
 	typ := uint64(req.Type)
 	_, err = mod.ExportedFunction("Validate").Call(ctx, typ)
+	err = r.callError(ctx, mod, err)
 
 	r.lock.Lock()
 	defer r.lock.Unlock()
-	return r.validationErrors[:], err
+	return &protogen.ValidationResponse{
+		Errors:   r.validationErrors[:],
+		Warnings: r.warnings[:],
+	}, err
 }
 
-func (r *Runner) Defaulting(ctx context.Context, req *protogen.SyncRequest) (*protogen.DefaultResponse, error) {
+func (r *Runner) Defaulting(ctx context.Context, req *protogen.SyncRequest) (_ *protogen.DefaultResponse, err error) {
 	ctx, span := tracing.Start(ctx, "WASI.Defaulting")
 	defer span.End()
+	defer r.recoverPanic("Defaulting", &err)
 	r.spanAttributes(span)
 
+	ctx, cancel := r.limits.callContext(ctx)
+	defer cancel()
+
+	r.syncRequest = req
+	r.defaulting = true
 	mod, err := r.instance(ctx)
 	if err != nil {
-		return nil, err
+		return nil, r.callError(ctx, nil, err)
 	}
 	defer mod.Close(ctx)
 
-	r.syncRequest = req
 	ret, err := mod.ExportedFunction("Defaulting").Call(ctx)
 	if err != nil {
-		return nil, err
+		return nil, r.callError(ctx, mod, err)
 	}
 
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	resp := &protogen.DefaultResponse{Warnings: r.warnings[:]}
+
 	ptrAndSize := uint64(ret[0])
 	if ptrAndSize == 0 {
-		return &protogen.DefaultResponse{}, nil
+		return resp, nil
 	}
 
 	ptr := ptrAndSize >> 32
@@ -178,7 +219,8 @@ func (r *Runner) Defaulting(ctx context.Context, req *protogen.SyncRequest) (*pr
 		return nil, fmt.Errorf("failed to read memory at %d with size %d", ptr, uint32(ptrAndSize))
 	}
 
-	return &protogen.DefaultResponse{Spec: b}, nil
+	resp.Spec = b
+	return resp, nil
 }
 
 type response struct {
@@ -191,6 +233,10 @@ func (r *response) Recv() (*protogen.Response, error) {
 		select {
 		case msg, ok := <-r.chn:
 			if !ok {
+				// The error is sent before the channels are closed.
+				if err, ok := <-r.errors; ok {
+					return nil, err
+				}
 				return nil, io.EOF
 			}
 			return msg, nil
@@ -216,41 +262,52 @@ func (r *Runner) Sync(ctx context.Context, req *protogen.SyncRequest) (Responder
 
 	r.syncRequest = req
 
+	ctx, cancel := r.limits.callContext(ctx)
 	mod, err := r.instance(ctx)
 	if err != nil {
-		return nil, err
+		cancel()
+		return nil, r.callError(ctx, nil, err)
 	}
 
 	go func() {
+		var err error
+		defer cancel()
 		defer mod.Close(ctx)
+		defer func() {
+			if err != nil {
+				res.errors <- err
+			}
+			close(r.msgs)
+			close(res.errors)
+		}()
+		defer r.recoverPanic("Sync", &err)
 
 		_, err = mod.ExportedFunction("Sync").Call(ctx)
-		if err != nil {
-			res.errors <- err
-		}
-
-		close(r.msgs)
-		close(res.errors)
+		err = r.callError(ctx, mod, err)
 	}()
 
 	return res, nil
 }
 
-func (r *Runner) Delete(ctx context.Context, req *protogen.SyncRequest) (*protogen.DeleteResponse, error) {
+func (r *Runner) Delete(ctx context.Context, req *protogen.SyncRequest) (_ *protogen.DeleteResponse, err error) {
 	ctx, span := tracing.Start(ctx, "WASI.Delete")
 	defer span.End()
+	defer r.recoverPanic("Delete", &err)
 	r.spanAttributes(span)
 
+	ctx, cancel := r.limits.callContext(ctx)
+	defer cancel()
+
+	r.syncRequest = req
 	mod, err := r.instance(ctx)
 	if err != nil {
-		return nil, err
+		return nil, r.callError(ctx, nil, err)
 	}
 	defer mod.Close(ctx)
 
-	r.syncRequest = req
 	res, err := mod.ExportedFunction("Delete").Call(ctx)
 	if err != nil {
-		return nil, err
+		return nil, r.callError(ctx, mod, err)
 	}
 
 	ptrAndSize := uint64(res[0])
@@ -351,6 +408,34 @@ func (r *Runner) addSidecar(ctx context.Context, m api.Module, ptr, size uint32)
 	}
 }
 
+// addVolume adds a volume to the pod of the workload.
+//
+// `ptr` and `size` are the pointer and size of the serialized
+// Volume proto.
+func (r *Runner) addVolume(ctx context.Context, m api.Module, ptr, size uint32) {
+	span := tracing.Get(ctx)
+	span.AddEvent("addVolume")
+	r.msgs <- &protogen.Response{
+		OFResponse: &protogen.Response_Volume{
+			Volume: unmarshalProto(m, &protogen.Volume{}, ptr, size),
+		},
+	}
+}
+
+// addVolumeMount mounts a volume in the main container of the workload.
+//
+// `ptr` and `size` are the pointer and size of the serialized
+// VolumeMount proto.
+func (r *Runner) addVolumeMount(ctx context.Context, m api.Module, ptr, size uint32) {
+	span := tracing.Get(ctx)
+	span.AddEvent("addVolumeMount")
+	r.msgs <- &protogen.Response{
+		OFResponse: &protogen.Response_VolumeMount{
+			VolumeMount: unmarshalProto(m, &protogen.VolumeMount{}, ptr, size),
+		},
+	}
+}
+
 // mergePatch applies a merge patch to the workload.
 //
 // `ptr` and `size` are the pointer and size of the serialized
@@ -360,7 +445,7 @@ func (r *Runner) mergePatch(ctx context.Context, m api.Module, ptr, size uint32)
 	span.AddEvent("addMergePatch")
 	b, ok := m.Memory().Read(ptr, size)
 	if !ok {
-		panic("failed to read memory")
+		hostPanic("mergePatch", errReadMemory)
 	}
 
 	r.msgs <- &protogen.Response{
@@ -370,6 +455,43 @@ func (r *Runner) mergePatch(ctx context.Context, m api.Module, ptr, size uint32)
 	}
 }
 
+// setStatus sets the status of the extension for the workload.
+// It replaces any status previously set during the same sync.
+//
+// `ptr` and `size` are the pointer and size of the serialized
+// ExtensionStatus proto.
+func (r *Runner) setStatus(ctx context.Context, m api.Module, ptr, size uint32) {
+	span := tracing.Get(ctx)
+	span.AddEvent("setStatus")
+	r.msgs <- &protogen.Response{
+		OFResponse: &protogen.Response_Status{
+			Status: unmarshalProto(m, &protogen.ExtensionStatus{}, ptr, size),
+		},
+	}
+}
+
+// recordEvent records a Kubernetes Event on the workload.
+// Events are rate limited per extension and workload.
+//
+// This is only valid for sync requests.
+//
+// `ptr` and `size` are the pointer and size of the serialized
+// Event proto.
+func (r *Runner) recordEvent(ctx context.Context, m api.Module, ptr, size uint32) {
+	span := tracing.Get(ctx)
+	span.AddEvent("recordEvent")
+
+	if r.msgs == nil {
+		hostPanic("recordEvent", errors.New("recordEvent is only valid for sync requests"))
+	}
+
+	r.msgs <- &protogen.Response{
+		OFResponse: &protogen.Response_Event{
+			Event: unmarshalProto(m, &protogen.Event{}, ptr, size),
+		},
+	}
+}
+
 // getOwner returns the OwnerReference proto of the workload.
 //
 // The returned value is a uint64 which uses the first 32 bits to
@@ -385,7 +507,7 @@ func (r *Runner) getOwner(ctx context.Context, m api.Module) uint64 {
 	} else if r.validationRequest != nil {
 		owner = r.validationRequest.Sync.Owner
 	} else {
-		panic("getOwner is only valid for sync or validation requests")
+		hostPanic("getOwner", errors.New("getOwner is only valid for sync or validation requests"))
 	}
 
 	return marshalProto(ctx, m, owner)
@@ -405,12 +527,30 @@ func (r *Runner) getSpec(ctx context.Context, m api.Module) uint64 {
 	} else if r.validationRequest != nil && r.validationRequest.Sync != nil {
 		b = r.validationRequest.Sync.Spec
 	} else {
-		panic("getSpec is not valid in this context")
+		hostPanic("getSpec", errors.New("getSpec is not valid in this context"))
 	}
 
 	return writeByteSlice(ctx, m, b)
 }
 
+// getOutputs returns the ExtensionOutputs proto with the outputs of the
+// extensions which ran in earlier stages of the sync.
+//
+// This is only valid for sync requests.
+//
+// The returned value is a uint64 which uses the first 32 bits to
+// store the pointer, and the last 32 bits to store the size.
+func (r *Runner) getOutputs(ctx context.Context, m api.Module) uint64 {
+	span := tracing.Get(ctx)
+	span.AddEvent("getOutputs")
+
+	if r.syncRequest == nil {
+		hostPanic("getOutputs", errors.New("getOutputs is only valid for sync requests"))
+	}
+
+	return marshalProto(ctx, m, &protogen.ExtensionOutputs{Outputs: r.syncRequest.Outputs})
+}
+
 // getOld returns the Old JSON of the workload.
 //
 // This is only valid for validation requests.
@@ -422,7 +562,7 @@ func (r *Runner) getOld(ctx context.Context, m api.Module) uint64 {
 	span.AddEvent("getOld")
 
 	if r.validationRequest == nil {
-		panic("getOld is only valid for validation requests")
+		hostPanic("getOld", errors.New("getOld is only valid for validation requests"))
 	}
 
 	return writeByteSlice(ctx, m, r.validationRequest.Old.Spec)
@@ -445,6 +585,30 @@ func (r *Runner) validationError(ctx context.Context, m api.Module, ptr, size ui
 	)
 }
 
+// validationWarning adds a warning shown to the user during a validation or
+// defaulting request, without rejecting the request.
+//
+// This is only valid for validation and defaulting requests.
+//
+// `ptr` and `size` are the pointer and size of the warning string.
+func (r *Runner) validationWarning(ctx context.Context, m api.Module, ptr, size uint32) {
+	span := tracing.Get(ctx)
+	span.AddEvent("validationWarning")
+
+	if r.validationRequest == nil && !r.defaulting {
+		hostPanic("validationWarning", errors.New("validationWarning is only valid for validation and defaulting requests"))
+	}
+
+	b, ok := m.Memory().Read(ptr, size)
+	if !ok {
+		hostPanic("validationWarning", errReadMemory)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.warnings = append(r.warnings, string(b))
+}
+
 // getResource returns a resource from the Kubernetes API server.
 //
 // `gvrPtr` and `gvrSize` are the pointer and size of the serialized
@@ -458,37 +622,82 @@ func (r *Runner) getResource(ctx context.Context, m api.Module, gvrPtr, gvrSize,
 	r.spanAttributes(span)
 
 	gvr := unmarshalProto(m, &protogen.GroupVersionResource{}, gvrPtr, gvrSize)
-	if err := r.isAllowed(ctx, gvr, "get"); err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+	client, err := r.resourceClient(ctx, gvr, "get")
+	if err != nil {
+		return r.clientError(ctx, err)
 	}
 
 	nameb, ok := m.Memory().Read(namePtr, nameSize)
 	if !ok {
-		panic("failed to read memory")
+		hostPanic("getResource", errReadMemory)
 	}
 
-	span.SetAttributes(attribute.String("resource.name", string(nameb)), attribute.String("resource.namespace", r.syncRequest.Owner.Namespace))
+	span.SetAttributes(attribute.String("resource.name", string(nameb)))
 
-	resource, err := r.client.Resource(schema.GroupVersionResource{
-		Group:    gvr.GetGroup(),
-		Version:  gvr.GetVersion(),
-		Resource: gvr.GetResource(),
-	}).Namespace(r.syncRequest.Owner.Namespace).Get(ctx, string(nameb), metav1.GetOptions{})
+	resource, err := client.Get(ctx, string(nameb), metav1.GetOptions{})
 	if err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+		return r.clientError(ctx, err)
 	}
 
 	b, err := resource.MarshalJSON()
 	if err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+		return r.clientError(ctx, err)
 	}
 
 	return writeByteSlice(ctx, m, b)
 }
 
+// listResources lists resources from the Kubernetes API server.
+//
+// `gvrPtr` and `gvrSize` are the pointer and size of the serialized
+// GroupVersionResource proto.
+//
+// `optsPtr` and `optsSize` are the pointer and size of the serialized
+// ListOptions proto.
+//
+// The returned value is the list as json, including the continue token in
+// its metadata when there are more resources.
+func (r *Runner) listResources(ctx context.Context, m api.Module, gvrPtr, gvrSize, optsPtr, optsSize uint32) uint64 {
+	ctx, span := tracing.Start(ctx, "WASI.ListResources")
+	defer span.End()
+	r.spanAttributes(span)
+
+	gvr := unmarshalProto(m, &protogen.GroupVersionResource{}, gvrPtr, gvrSize)
+	opts := unmarshalProto(m, &protogen.ListOptions{}, optsPtr, optsSize)
+
+	b, err := r.list(ctx, gvr, opts)
+	if err != nil {
+		return r.clientError(ctx, err)
+	}
+
+	return writeByteSlice(ctx, m, b)
+}
+
+// list returns the resources selected by opts as json.
+func (r *Runner) list(ctx context.Context, gvr *protogen.GroupVersionResource, opts *protogen.ListOptions) ([]byte, error) {
+	client, err := r.resourceClient(ctx, gvr, "list")
+	if err != nil {
+		return nil, err
+	}
+
+	tracing.Get(ctx).SetAttributes(
+		attribute.String("resource.labelSelector", opts.GetLabelSelector()),
+		attribute.String("resource.fieldSelector", opts.GetFieldSelector()),
+	)
+
+	list, err := client.List(ctx, metav1.ListOptions{
+		LabelSelector: opts.GetLabelSelector(),
+		FieldSelector: opts.GetFieldSelector(),
+		Limit:         opts.GetLimit(),
+		Continue:      opts.GetContinue(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.MarshalJSON()
+}
+
 // deleteResource deletes a resource from the Kubernetes API server.
 //
 // `gvrPtr` and `gvrSize` are the pointer and size of the serialized
@@ -502,26 +711,20 @@ func (r *Runner) deleteResource(ctx context.Context, m api.Module, gvrPtr, gvrSi
 	r.spanAttributes(span)
 
 	gvr := unmarshalProto(m, &protogen.GroupVersionResource{}, gvrPtr, gvrSize)
-	if err := r.isAllowed(ctx, gvr, "delete"); err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+	client, err := r.resourceClient(ctx, gvr, "delete")
+	if err != nil {
+		return r.clientError(ctx, err)
 	}
 
 	nameb, ok := m.Memory().Read(namePtr, nameSize)
 	if !ok {
-		panic("failed to read memory")
+		hostPanic("deleteResource", errReadMemory)
 	}
 
-	span.SetAttributes(attribute.String("resource.name", string(nameb)), attribute.String("resource.namespace", r.syncRequest.Owner.Namespace))
+	span.SetAttributes(attribute.String("resource.name", string(nameb)))
 
-	err := r.client.Resource(schema.GroupVersionResource{
-		Group:    gvr.GetGroup(),
-		Version:  gvr.GetVersion(),
-		Resource: gvr.GetResource(),
-	}).Namespace(r.syncRequest.Owner.Namespace).Delete(ctx, string(nameb), metav1.DeleteOptions{})
-	if err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+	if err := client.Delete(ctx, string(nameb), metav1.DeleteOptions{}); err != nil {
+		return r.clientError(ctx, err)
 	}
 	return 0
 }
@@ -539,36 +742,31 @@ func (r *Runner) createResource(ctx context.Context, m api.Module, gvrPtr, gvrSi
 	r.spanAttributes(span)
 
 	gvr := unmarshalProto(m, &protogen.GroupVersionResource{}, gvrPtr, gvrSize)
-	if err := r.isAllowed(ctx, gvr, "create"); err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+	client, err := r.resourceClient(ctx, gvr, "create")
+	if err != nil {
+		return r.clientError(ctx, err)
 	}
 
 	b, ok := m.Memory().Read(specPtr, specSize)
 	if !ok {
-		panic("failed to read memory")
+		hostPanic("createResource", errReadMemory)
 	}
 
 	resource := &unstructured.Unstructured{}
 	if err := resource.UnmarshalJSON(b); err != nil {
-		panic("failed to unmarshal resource: " + err.Error())
+		hostPanic("createResource", fmt.Errorf("failed to unmarshal resource: %w", err))
 	}
 
-	span.SetAttributes(attribute.String("resource.name", resource.GetName()), attribute.String("resource.namespace", r.syncRequest.Owner.Namespace))
+	span.SetAttributes(attribute.String("resource.name", resource.GetName()))
 	// TODO: Is there some way to create a dynamic lister for any resouce requested?
-	n, err := r.client.Resource(schema.GroupVersionResource{
-		Group:    gvr.GetGroup(),
-		Version:  gvr.GetVersion(),
-		Resource: gvr.GetResource(),
-	}).Namespace(r.syncRequest.Owner.Namespace).Create(ctx, resource, metav1.CreateOptions{})
+	n, err := client.Create(ctx, resource, metav1.CreateOptions{})
 	if err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+		return r.clientError(ctx, err)
 	}
 
 	b, err = n.MarshalJSON()
 	if err != nil {
-		panic("failed to marshal resource: " + err.Error())
+		hostPanic("createResource", fmt.Errorf("failed to marshal resource: %w", err))
 	}
 
 	return writeByteSlice(ctx, m, b)
@@ -587,42 +785,163 @@ func (r *Runner) updateResource(ctx context.Context, m api.Module, gvrPtr, gvrSi
 	r.spanAttributes(span)
 
 	gvr := unmarshalProto(m, &protogen.GroupVersionResource{}, gvrPtr, gvrSize)
-	if err := r.isAllowed(ctx, gvr, "update"); err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+	client, err := r.resourceClient(ctx, gvr, "update")
+	if err != nil {
+		return r.clientError(ctx, err)
 	}
 
 	b, ok := m.Memory().Read(specPtr, specSize)
 	if !ok {
-		panic("failed to read memory")
+		hostPanic("updateResource", errReadMemory)
 	}
 
 	resource := &unstructured.Unstructured{}
 	if err := resource.UnmarshalJSON(b); err != nil {
-		panic("failed to unmarshal resource: " + err.Error())
+		hostPanic("updateResource", fmt.Errorf("failed to unmarshal resource: %w", err))
 	}
 
-	span.SetAttributes(attribute.String("resource.name", resource.GetName()), attribute.String("resource.namespace", r.syncRequest.Owner.Namespace))
+	span.SetAttributes(attribute.String("resource.name", resource.GetName()))
 	// TODO: Is there some way to create a dynamic lister for any resouce requested?
-	n, err := r.client.Resource(schema.GroupVersionResource{
-		Group:    gvr.GetGroup(),
-		Version:  gvr.GetVersion(),
-		Resource: gvr.GetResource(),
-	}).Namespace(r.syncRequest.Owner.Namespace).Update(ctx, resource, metav1.UpdateOptions{})
+	n, err := client.Update(ctx, resource, metav1.UpdateOptions{})
 	if err != nil {
-		log.Println(err)
-		return uint64(toClientError(err))
+		return r.clientError(ctx, err)
 	}
 
 	b, err = n.MarshalJSON()
 	if err != nil {
-		panic("failed to marshal resource: " + err.Error())
+		hostPanic("updateResource", fmt.Errorf("failed to marshal resource: %w", err))
+	}
+
+	return writeByteSlice(ctx, m, b)
+}
+
+// patchResource patches a resource in the Kubernetes API server.
+//
+// `gvrPtr` and `gvrSize` are the pointer and size of the serialized
+// GroupVersionResource proto.
+//
+// `namePtr` and `nameSize` are the pointer and size of the serialized
+// string name of the resource.
+//
+// `patchType` is the PatchType enum value of the patch.
+//
+// `patchPtr` and `patchSize` are the pointer and size of the patch.
+func (r *Runner) patchResource(ctx context.Context, m api.Module, gvrPtr, gvrSize, namePtr, nameSize, patchType, patchPtr, patchSize uint32) uint64 {
+	ctx, span := tracing.Start(ctx, "WASI.PatchResource")
+	defer span.End()
+	r.spanAttributes(span)
+
+	gvr := unmarshalProto(m, &protogen.GroupVersionResource{}, gvrPtr, gvrSize)
+
+	nameb, ok := m.Memory().Read(namePtr, nameSize)
+	if !ok {
+		hostPanic("patchResource", errReadMemory)
+	}
+
+	patch, ok := m.Memory().Read(patchPtr, patchSize)
+	if !ok {
+		hostPanic("patchResource", errReadMemory)
+	}
+
+	b, err := r.patch(ctx, gvr, string(nameb), protogen.PatchType(patchType), patch)
+	if err != nil {
+		return r.clientError(ctx, err)
+	}
+
+	return writeByteSlice(ctx, m, b)
+}
+
+// patch patches the named resource, returning the patched resource as json.
+func (r *Runner) patch(ctx context.Context, gvr *protogen.GroupVersionResource, name string, patchType protogen.PatchType, patch []byte) ([]byte, error) {
+	client, err := r.resourceClient(ctx, gvr, "patch")
+	if err != nil {
+		return nil, err
+	}
+
+	var pt types.PatchType
+	switch patchType {
+	case protogen.PatchType_MERGE:
+		pt = types.MergePatchType
+	case protogen.PatchType_STRATEGIC_MERGE:
+		pt = types.StrategicMergePatchType
+	case protogen.PatchType_JSON:
+		pt = types.JSONPatchType
+	default:
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("unknown patch type %d", patchType))
+	}
+
+	tracing.Get(ctx).SetAttributes(
+		attribute.String("resource.name", name),
+		attribute.String("resource.patchType", string(pt)),
+	)
+
+	resource, err := client.Patch(ctx, name, pt, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.MarshalJSON()
+}
+
+// applyResource creates or updates a resource in the Kubernetes API server
+// using server-side apply, with the name of the extension as field manager.
+// Applying a value to a field owned by another field manager fails with a
+// conflict.
+//
+// `gvrPtr` and `gvrSize` are the pointer and size of the serialized
+// GroupVersionResource proto.
+//
+// `specPtr` and `specSize` are the pointer and size of the serialized
+// Resource json.
+func (r *Runner) applyResource(ctx context.Context, m api.Module, gvrPtr, gvrSize, specPtr, specSize uint32) uint64 {
+	ctx, span := tracing.Start(ctx, "WASI.ApplyResource")
+	defer span.End()
+	r.spanAttributes(span)
+
+	gvr := unmarshalProto(m, &protogen.GroupVersionResource{}, gvrPtr, gvrSize)
+
+	b, ok := m.Memory().Read(specPtr, specSize)
+	if !ok {
+		hostPanic("applyResource", errReadMemory)
+	}
+
+	resource := &unstructured.Unstructured{}
+	if err := resource.UnmarshalJSON(b); err != nil {
+		hostPanic("applyResource", fmt.Errorf("failed to unmarshal resource: %w", err))
+	}
+
+	b, err := r.apply(ctx, gvr, resource)
+	if err != nil {
+		return r.clientError(ctx, err)
 	}
 
 	return writeByteSlice(ctx, m, b)
 }
 
-func (r *Runner) isAllowed(ctx context.Context, gvr *protogen.GroupVersionResource, method string) error {
+// apply applies resource, returning the resulting resource as json.
+func (r *Runner) apply(ctx context.Context, gvr *protogen.GroupVersionResource, resource *unstructured.Unstructured) ([]byte, error) {
+	client, err := r.resourceClient(ctx, gvr, "apply")
+	if err != nil {
+		return nil, err
+	}
+
+	tracing.Get(ctx).SetAttributes(attribute.String("resource.name", resource.GetName()))
+
+	resource.SetManagedFields(nil)
+	resource.SetResourceVersion("")
+	n, err := client.Apply(ctx, resource.GetName(), resource, metav1.ApplyOptions{FieldManager: r.name})
+	if err != nil {
+		return nil, err
+	}
+
+	return n.MarshalJSON()
+}
+
+// resourceClient checks that the extension may use method on the resource,
+// and returns a client for it. Namespaced resources are accessed in the
+// namespace of the owner, unless another namespace the extension is configured
+// to access is requested.
+func (r *Runner) resourceClient(ctx context.Context, gvr *protogen.GroupVersionResource, method string) (dynamic.ResourceInterface, error) {
 	span := tracing.Get(ctx)
 	span.SetAttributes(
 		attribute.String("resource.group", gvr.GetGroup()),
@@ -631,26 +950,60 @@ func (r *Runner) isAllowed(ctx context.Context, gvr *protogen.GroupVersionResour
 		attribute.String("resource.method", method),
 	)
 
-	if r.clientPermissions != nil {
-		_, ok := r.clientPermissions[gvr.GetGroup()+"/"+gvr.GetVersion()+"/"+gvr.GetResource()+"."+method]
-		if ok {
-			return nil
+	gr := schema.GroupResource{Group: gvr.GetGroup(), Resource: gvr.GetResource()}
+	perm, ok := r.clientPermissions[gvr.GetGroup()+"/"+gvr.GetVersion()+"/"+gvr.GetResource()+"."+method]
+	if !ok {
+		err := fmt.Errorf("extension is not configured to access this resource with method %q", method)
+		span.RecordError(err)
+		return nil, apierrors.NewForbidden(gr, "", err)
+	}
+
+	client := r.client.Resource(schema.GroupVersionResource{
+		Group:    gvr.GetGroup(),
+		Version:  gvr.GetVersion(),
+		Resource: gvr.GetResource(),
+	})
+	if perm.Cluster {
+		if gvr.GetNamespace() != "" {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("%s is cluster scoped and can't be accessed in namespace %q", gr, gvr.GetNamespace()))
 		}
+		return client, nil
 	}
 
-	err := fmt.Errorf("extension is not configured to access this resource with method %q", method)
-	span.RecordError(err)
-	return apierrors.NewForbidden(schema.GroupResource{Group: gvr.GetGroup(), Resource: gvr.GetResource()}, "", err)
+	namespace := r.owner().GetNamespace()
+	if ns := gvr.GetNamespace(); ns != "" && ns != namespace {
+		if !slices.Contains(perm.Namespaces, ns) {
+			err := fmt.Errorf("extension is not configured to access this resource in namespace %q", ns)
+			span.RecordError(err)
+			return nil, apierrors.NewForbidden(gr, "", err)
+		}
+		namespace = ns
+	}
+	if namespace == "" {
+		// An empty namespace would access the resource in all namespaces.
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("no namespace to access %s in", gr))
+	}
+
+	span.SetAttributes(attribute.String("resource.namespace", namespace))
+	return client.Namespace(namespace), nil
+}
+
+// owner returns the owner of the current request, or nil outside of requests.
+func (r *Runner) owner() *protogen.Owner {
+	if r.syncRequest != nil {
+		return r.syncRequest.GetOwner()
+	}
+	return r.validationRequest.GetSync().GetOwner()
 }
 
 func unmarshalProto[T protoreflect.ProtoMessage](m api.Module, v T, ptr, size uint32) T {
 	b, ok := m.Memory().Read(ptr, size)
 	if !ok {
-		panic("failed to read memory")
+		hostPanic("unmarshalProto", errReadMemory)
 	}
 
 	if err := proto.Unmarshal(b, v); err != nil {
-		panic("unable to unmarshal: " + err.Error())
+		hostPanic("unmarshalProto", fmt.Errorf("unable to unmarshal: %w", err))
 	}
 	return v
 }
@@ -658,7 +1011,7 @@ func unmarshalProto[T protoreflect.ProtoMessage](m api.Module, v T, ptr, size ui
 func marshalProto(ctx context.Context, m api.Module, v protoreflect.ProtoMessage) uint64 {
 	b, err := proto.Marshal(v)
 	if err != nil {
-		panic("marshalProto: " + err.Error())
+		hostPanic("marshalProto", err)
 	}
 	return writeByteSlice(ctx, m, b)
 }
@@ -666,12 +1019,12 @@ func marshalProto(ctx context.Context, m api.Module, v protoreflect.ProtoMessage
 func writeByteSlice(ctx context.Context, m api.Module, b []byte) uint64 {
 	res, err := m.ExportedFunction("malloc").Call(ctx, uint64(len(b)))
 	if err != nil {
-		panic("marshalProto: " + err.Error())
+		hostPanic("writeByteSlice", err)
 	}
 
 	ptr := res[0]
 	if ok := m.Memory().Write(uint32(ptr), b); !ok {
-		panic("marshalProto: unable to write to memory")
+		hostPanic("writeByteSlice", errWriteMemory)
 	}
 
 	return uint64(ptr)<<32 | uint64(len(b))
@@ -683,7 +1036,7 @@ func readByteSlice(ctx context.Context, m api.Module, ptrAndSize uint64) []byte
 
 	b, ok := m.Memory().Read(ptr, size)
 	if !ok {
-		panic("failed to read memory")
+		hostPanic("readByteSlice", errReadMemory)
 	}
 	return b
 }
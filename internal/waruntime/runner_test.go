@@ -0,0 +1,257 @@
+package waruntime
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/suffiks/suffiks/extension/protogen"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+func TestRunner_list(t *testing.T) {
+	secret := func(namespace, name string, labels map[string]string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("v1")
+		u.SetKind("Secret")
+		u.SetNamespace(namespace)
+		u.SetName(name)
+		u.SetLabels(labels)
+		return u
+	}
+
+	namespace := func(name string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("v1")
+		u.SetKind("Namespace")
+		u.SetName(name)
+		return u
+	}
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "secrets"}:    "SecretList",
+		{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+	},
+		secret("team", "app-db", map[string]string{"app": "app"}),
+		secret("team", "app-cache", map[string]string{"app": "app"}),
+		secret("team", "other", map[string]string{"app": "other"}),
+		secret("other-team", "app-db", map[string]string{"app": "app"}),
+		namespace("team"),
+		namespace("other-team"),
+	)
+
+	secrets := &protogen.GroupVersionResource{Version: ptr.To("v1"), Resource: ptr.To("secrets")}
+	secretsIn := func(namespace string) *protogen.GroupVersionResource {
+		return &protogen.GroupVersionResource{Version: ptr.To("v1"), Resource: ptr.To("secrets"), Namespace: ptr.To(namespace)}
+	}
+	namespaces := &protogen.GroupVersionResource{Version: ptr.To("v1"), Resource: ptr.To("namespaces")}
+
+	tests := map[string]struct {
+		gvr         *protogen.GroupVersionResource
+		permissions map[string]Permission
+		opts        *protogen.ListOptions
+		want        []string
+		wantErr     func(error) bool
+	}{
+		"all in namespace": {
+			gvr:         secrets,
+			permissions: map[string]Permission{"/v1/secrets.list": {}},
+			opts:        &protogen.ListOptions{},
+			want:        []string{"app-cache", "app-db", "other"},
+		},
+		"label selector": {
+			gvr:         secrets,
+			permissions: map[string]Permission{"/v1/secrets.list": {}},
+			opts:        &protogen.ListOptions{LabelSelector: "app=app"},
+			want:        []string{"app-cache", "app-db"},
+		},
+		"not allowed": {
+			gvr:         secrets,
+			permissions: map[string]Permission{"/v1/secrets.get": {}},
+			opts:        &protogen.ListOptions{},
+			wantErr:     apierrors.IsForbidden,
+		},
+		"namespace of owner": {
+			gvr:         secretsIn("team"),
+			permissions: map[string]Permission{"/v1/secrets.list": {}},
+			opts:        &protogen.ListOptions{LabelSelector: "app=app"},
+			want:        []string{"app-cache", "app-db"},
+		},
+		"allowed namespace": {
+			gvr:         secretsIn("other-team"),
+			permissions: map[string]Permission{"/v1/secrets.list": {Namespaces: []string{"other-team"}}},
+			opts:        &protogen.ListOptions{},
+			want:        []string{"app-db"},
+		},
+		"namespace not allowed": {
+			gvr:         secretsIn("other-team"),
+			permissions: map[string]Permission{"/v1/secrets.list": {}},
+			opts:        &protogen.ListOptions{},
+			wantErr:     apierrors.IsForbidden,
+		},
+		"cluster scoped": {
+			gvr:         namespaces,
+			permissions: map[string]Permission{"/v1/namespaces.list": {Cluster: true}},
+			opts:        &protogen.ListOptions{},
+			want:        []string{"other-team", "team"},
+		},
+		"cluster scoped in namespace": {
+			gvr:         &protogen.GroupVersionResource{Version: ptr.To("v1"), Resource: ptr.To("namespaces"), Namespace: ptr.To("team")},
+			permissions: map[string]Permission{"/v1/namespaces.list": {Cluster: true}},
+			opts:        &protogen.ListOptions{},
+			wantErr:     apierrors.IsBadRequest,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &Runner{
+				client:            client,
+				clientPermissions: tc.permissions,
+				syncRequest:       &protogen.SyncRequest{Owner: &protogen.Owner{Namespace: "team"}},
+			}
+
+			b, err := r.list(context.Background(), tc.gvr, tc.opts)
+			if tc.wantErr != nil {
+				if !tc.wantErr(err) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			list := &unstructured.UnstructuredList{}
+			if err := json.Unmarshal(b, list); err != nil {
+				t.Fatal(err)
+			}
+
+			got := []string{}
+			for _, item := range list.Items {
+				got = append(got, item.GetName())
+			}
+			slices.Sort(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected resources (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunner_patch(t *testing.T) {
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetNamespace("team")
+	cm.SetName("app")
+	if err := unstructured.SetNestedStringMap(cm.Object, map[string]string{"a": "1"}, "data"); err != nil {
+		t.Fatal(err)
+	}
+
+	gvr := &protogen.GroupVersionResource{Version: ptr.To("v1"), Resource: ptr.To("configmaps")}
+	allowed := map[string]Permission{"/v1/configmaps.patch": {}}
+
+	tests := map[string]struct {
+		permissions map[string]Permission
+		patchType   protogen.PatchType
+		patch       string
+		want        map[string]string
+		wantErr     func(error) bool
+	}{
+		"merge": {
+			permissions: allowed,
+			patchType:   protogen.PatchType_MERGE,
+			patch:       `{"data":{"b":"2"}}`,
+			want:        map[string]string{"a": "1", "b": "2"},
+		},
+		"json": {
+			permissions: allowed,
+			patchType:   protogen.PatchType_JSON,
+			patch:       `[{"op":"replace","path":"/data/a","value":"3"}]`,
+			want:        map[string]string{"a": "3"},
+		},
+		"unknown patch type": {
+			permissions: allowed,
+			patchType:   protogen.PatchType(42),
+			patch:       `{}`,
+			wantErr:     apierrors.IsBadRequest,
+		},
+		"not allowed": {
+			permissions: map[string]Permission{"/v1/configmaps.update": {}},
+			patchType:   protogen.PatchType_MERGE,
+			patch:       `{"data":{"b":"2"}}`,
+			wantErr:     apierrors.IsForbidden,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &Runner{
+				client:            fake.NewSimpleDynamicClient(runtime.NewScheme(), cm.DeepCopy()),
+				clientPermissions: tc.permissions,
+				syncRequest:       &protogen.SyncRequest{Owner: &protogen.Owner{Namespace: "team"}},
+			}
+
+			b, err := r.patch(context.Background(), gvr, "app", tc.patchType, []byte(tc.patch))
+			if tc.wantErr != nil {
+				if !tc.wantErr(err) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := &unstructured.Unstructured{}
+			if err := got.UnmarshalJSON(b); err != nil {
+				t.Fatal(err)
+			}
+			data, _, _ := unstructured.NestedStringMap(got.Object, "data")
+			if diff := cmp.Diff(tc.want, data); diff != "" {
+				t.Errorf("unexpected data (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunner_apply(t *testing.T) {
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetName("app")
+
+	r := &Runner{
+		name:              "ext",
+		client:            fake.NewSimpleDynamicClient(runtime.NewScheme()),
+		clientPermissions: map[string]Permission{"/v1/configmaps.patch": {}},
+		syncRequest:       &protogen.SyncRequest{Owner: &protogen.Owner{Namespace: "team"}},
+	}
+
+	gvr := &protogen.GroupVersionResource{Version: ptr.To("v1"), Resource: ptr.To("configmaps")}
+	if _, err := r.apply(context.Background(), gvr, cm); !apierrors.IsForbidden(err) {
+		t.Fatalf("expected apply to require the apply method, got %v", err)
+	}
+
+	r.clientPermissions = map[string]Permission{"/v1/configmaps.apply": {}}
+	_, _ = r.apply(context.Background(), gvr, cm) // The fake client doesn't implement server-side apply.
+
+	actions := r.client.(*fake.FakeDynamicClient).Actions()
+	if len(actions) != 1 {
+		t.Fatalf("expected 1 action, got %d", len(actions))
+	}
+	patch, ok := actions[0].(k8stesting.PatchAction)
+	if !ok || patch.GetPatchType() != types.ApplyPatchType || patch.GetName() != "app" || patch.GetNamespace() != "team" {
+		t.Errorf("expected an apply patch of team/app, got %#v", actions[0])
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -26,13 +27,13 @@ func TestRun(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	perm := map[string]struct{}{
+	perm := map[string]waruntime.Permission{
 		"networking.k8s.io/v1/ingresses.create": {},
 		"networking.k8s.io/v1/ingresses.get":    {},
 		"networking.k8s.io/v1/ingresses.update": {},
 	}
 
-	if err := r.Load(ctx, "test", "0.1.1", b, perm, nil); err != nil {
+	if err := r.Load(ctx, "test", "0.1.1", b, perm, nil, waruntime.Limits{}); err != nil {
 		t.Fatal(err)
 	}
 
@@ -59,13 +60,14 @@ func TestRun(t *testing.T) {
 		Spec: []byte(`{"ingresses":[{"host":"suffiks"}, {"host":"suffiks.com", "paths":["test"]}]}`),
 	}
 
-	errs, err := runner.Validate(ctx, &protogen.ValidationRequest{
+	resp, err := runner.Validate(ctx, &protogen.ValidationRequest{
 		Type: protogen.ValidationType_CREATE,
 		Sync: syncReq,
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
+	errs := resp.Errors
 
 	expected := []*protogen.ValidationError{
 		{
@@ -219,3 +221,219 @@ func TestRun(t *testing.T) {
 		t.Fatalf("expected 4 action, got %d", len(actions))
 	}
 }
+
+func TestLoad_Upgrade(t *testing.T) {
+	ctx := context.Background()
+	r := waruntime.New(ctx)
+	defer r.Close(ctx)
+
+	b, err := os.ReadFile("./testdata/as/build/release.wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Load(ctx, "test", "0.1.1", b, nil, nil, waruntime.Limits{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Keep a runner from the old version around while upgrading.
+	oldRunner, err := r.NewRunner(ctx, "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer oldRunner.Close(ctx)
+
+	if err := r.Load(ctx, "test", "0.1.2", []byte("not a wasm module"), nil, nil, waruntime.Limits{}); err == nil {
+		t.Fatal("expected error loading invalid module")
+	}
+	if v, _ := r.Version("test"); v != "0.1.1" {
+		t.Errorf("expected version 0.1.1 after failed upgrade, got %q", v)
+	}
+
+	if err := r.Load(ctx, "test", "0.1.2", b, nil, nil, waruntime.Limits{}); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := r.Version("test"); v != "0.1.2" {
+		t.Errorf("expected version 0.1.2, got %q", v)
+	}
+
+	// Loading the same version again does not require the module.
+	if err := r.Load(ctx, "test", "0.1.2", nil, nil, nil, waruntime.Limits{}); err != nil {
+		t.Fatal(err)
+	}
+
+	runner, err := r.NewRunner(ctx, "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer runner.Close(ctx)
+
+	if _, err := runner.Validate(ctx, &protogen.ValidationRequest{
+		Type: protogen.ValidationType_CREATE,
+		Sync: &protogen.SyncRequest{
+			Owner: &protogen.Owner{Kind: "Application", Name: "my-app", Namespace: "some-namespace"},
+			Spec:  []byte(`{"ingresses":[{"host":"suffiks.com","paths":["/"]}]}`),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad_LimitsChanged(t *testing.T) {
+	ctx := context.Background()
+	r := waruntime.New(ctx)
+	defer r.Close(ctx)
+
+	b, err := os.ReadFile("./testdata/as/build/release.wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	perm := map[string]waruntime.Permission{
+		"GET":    {},
+		"CREATE": {},
+		"UPDATE": {},
+		"DELETE": {},
+	}
+	if err := r.Load(ctx, "test", "0.1.1", b, perm, nil, waruntime.Limits{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Changing only the memory limit recompiles the module, sharing the
+	// compiled code with the previous one.
+	if err := r.Load(ctx, "test", "0.1.1", nil, perm, nil, waruntime.Limits{MaxMemoryPages: 256}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		runner, err := r.NewRunner(ctx, "test", fake.NewSimpleDynamicClient(runtime.NewScheme()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := runner.Sync(ctx, &protogen.SyncRequest{
+			Owner: &protogen.Owner{Kind: "Application", Name: "my-app", Namespace: "some-namespace"},
+			Spec:  []byte(`{"ingresses":[{"host":"suffiks.com","paths":["/"]}]}`),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for {
+			if _, err := res.Recv(); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				t.Fatal(err)
+			}
+		}
+		runner.Close(ctx)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	ctx := context.Background()
+	r := waruntime.New(ctx)
+	defer r.Close(ctx)
+
+	limits := waruntime.Limits{
+		MaxMemoryPages:     4,
+		Timeout:            50 * time.Millisecond,
+		CloseOnContextDone: true,
+	}
+	if err := r.Load(ctx, "limits", "0.1.0", limitsModule(), nil, nil, limits); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("timeout", func(t *testing.T) {
+		runner, err := r.NewRunner(ctx, "limits", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := runner.Sync(ctx, &protogen.SyncRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = res.Recv()
+		var limitErr *waruntime.LimitError
+		if !errors.As(err, &limitErr) || limitErr.Limit != waruntime.LimitTimeout {
+			t.Fatalf("expected timeout limit error, got %v", err)
+		}
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		runner, err := r.NewRunner(ctx, "limits", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = runner.Validate(ctx, &protogen.ValidationRequest{})
+		var limitErr *waruntime.LimitError
+		if !errors.As(err, &limitErr) || limitErr.Limit != waruntime.LimitMemory {
+			t.Fatalf("expected memory limit error, got %v", err)
+		}
+	})
+}
+
+// limitsModule returns a module implementing the required functions, where
+// Sync loops forever and Validate grows memory until it fails.
+func limitsModule() []byte {
+	section := func(id byte, content ...byte) []byte {
+		return append([]byte{id, byte(len(content))}, content...)
+	}
+	name := func(s string) []byte {
+		return append([]byte{byte(len(s))}, s...)
+	}
+	body := func(code ...byte) []byte {
+		return append([]byte{byte(len(code) + 1), 0x00}, code...)
+	}
+
+	types := []byte{
+		4,
+		0x60, 0, 0, // () -> ()
+		0x60, 0, 1, 0x7e, // () -> i64
+		0x60, 1, 0x7f, 0, // (i32) -> ()
+		0x60, 1, 0x7f, 1, 0x7f, // (i32) -> i32
+	}
+	funcs := []byte{5, 0, 1, 2, 3, 2}
+	memory := []byte{1, 0x00, 1}
+
+	exports := []byte{7}
+	for _, e := range []struct {
+		name string
+		kind byte
+		idx  byte
+	}{
+		{"Sync", 0, 0},
+		{"Delete", 0, 1},
+		{"Defaulting", 0, 1},
+		{"Validate", 0, 2},
+		{"malloc", 0, 3},
+		{"free", 0, 4},
+		{"memory", 2, 0},
+	} {
+		exports = append(exports, name(e.name)...)
+		exports = append(exports, e.kind, e.idx)
+	}
+
+	code := []byte{5}
+	// Sync: loop br 0 end
+	code = append(code, body(0x03, 0x40, 0x0c, 0x00, 0x0b, 0x0b)...)
+	// Delete and Defaulting: i64.const 0
+	code = append(code, body(0x42, 0x00, 0x0b)...)
+	// Validate: grow memory by one page until it fails, then trap
+	code = append(code, body(0x03, 0x40, 0x41, 0x01, 0x40, 0x00, 0x41, 0x7f, 0x47, 0x0d, 0x00, 0x0b, 0x00, 0x0b)...)
+	// malloc: i32.const 0
+	code = append(code, body(0x41, 0x00, 0x0b)...)
+	// free
+	code = append(code, body(0x0b)...)
+
+	mod := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	mod = append(mod, section(1, types...)...)
+	mod = append(mod, section(3, funcs...)...)
+	mod = append(mod, section(5, memory...)...)
+	mod = append(mod, section(7, exports...)...)
+	mod = append(mod, section(10, code...)...)
+	return mod
+}
@@ -5,19 +5,39 @@ package v1
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/mitchellh/hashstructure/v2"
 	"github.com/perimeterx/marshmallow"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 type ResourceRequirementsLimits struct {
+	// Number of CPU units to limit.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+
 	// Number of bytes to limit.
 	// +kubebuilder:validation:Required
 	Memory resource.Quantity `json:"memory"`
+
+	// Number of bytes of local ephemeral storage to limit.
+	// +optional
+	EphemeralStorage *resource.Quantity `json:"ephemeralStorage,omitempty"`
+
+	// Extended resources, such as `nvidia.com/gpu`, to allocate.
+	// Names must be fully qualified and outside the `kubernetes.io` domain.
+	// Extended resources can't be overcommitted, so the same quantity is requested.
+	// +optional
+	Extended map[string]resource.Quantity `json:"extended,omitempty"`
 }
 
 type ResourceRequirementsRequests struct {
@@ -28,6 +48,10 @@ type ResourceRequirementsRequests struct {
 	// Number of bytes to request.
 	// +kubebuilder:validation:Required
 	Memory resource.Quantity `json:"memory"`
+
+	// Number of bytes of local ephemeral storage to request.
+	// +optional
+	EphemeralStorage *resource.Quantity `json:"ephemeralStorage,omitempty"`
 }
 
 type ResourceRequirements struct {
@@ -37,6 +61,72 @@ type ResourceRequirements struct {
 	Requests ResourceRequirementsRequests `json:"requests"`
 }
 
+// Validate checks that extended resource names are valid, and that requests
+// don't exceed limits.
+func (r *ResourceRequirements) Validate(path *field.Path) field.ErrorList {
+	if r == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	limits, requests := path.Child("limits"), path.Child("requests")
+
+	if r.Limits.CPU != nil && r.Requests.CPU.Cmp(*r.Limits.CPU) > 0 {
+		errs = append(errs, field.Invalid(requests.Child("cpu"), r.Requests.CPU.String(), "must be less than or equal to the cpu limit"))
+	}
+	if r.Requests.Memory.Cmp(r.Limits.Memory) > 0 {
+		errs = append(errs, field.Invalid(requests.Child("memory"), r.Requests.Memory.String(), "must be less than or equal to the memory limit"))
+	}
+	if r.Limits.EphemeralStorage != nil && r.Requests.EphemeralStorage != nil && r.Requests.EphemeralStorage.Cmp(*r.Limits.EphemeralStorage) > 0 {
+		errs = append(errs, field.Invalid(requests.Child("ephemeralStorage"), r.Requests.EphemeralStorage.String(), "must be less than or equal to the ephemeralStorage limit"))
+	}
+
+	names := make([]string, 0, len(r.Limits.Extended))
+	for name := range r.Limits.Extended {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := limits.Child("extended").Key(name)
+		if msgs := validation.IsQualifiedName(name); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(p, name, strings.Join(msgs, ", ")))
+			continue
+		}
+		domain, _, ok := strings.Cut(name, "/")
+		if !ok || domain == "kubernetes.io" || strings.HasSuffix(domain, ".kubernetes.io") {
+			errs = append(errs, field.Invalid(p, name, "must be a fully qualified name outside the kubernetes.io domain"))
+			continue
+		}
+		if q := r.Limits.Extended[name]; q.Sign() <= 0 || q.MilliValue()%1000 != 0 {
+			errs = append(errs, field.Invalid(p, q.String(), "must be a positive whole number"))
+		}
+	}
+
+	return errs
+}
+
+// ApplicationDefaults are cluster wide defaults applied to Applications by the
+// defaulting webhook.
+type ApplicationDefaults struct {
+	// Resources used when an Application doesn't specify any.
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// Labels added to Applications not already having them.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Validate checks that the default resources and labels are valid.
+func (d *ApplicationDefaults) Validate(path *field.Path) field.ErrorList {
+	if d == nil {
+		return nil
+	}
+
+	errs := d.Resources.Validate(path.Child("resources"))
+	return append(errs, metav1validation.ValidateLabels(d.Labels, path.Child("labels"))...)
+}
+
 type EnvVars []EnvVar
 
 type EnvVar struct {
@@ -67,11 +157,171 @@ type EnvFrom struct {
 	Secret string `json:"secret,omitempty"`
 }
 
+// Volume is a volume of the application pods.
+// +kubebuilder:validation:XValidation:rule="(has(self.configMap) ? 1 : 0) + (has(self.secret) ? 1 : 0) + (has(self.emptyDir) ? 1 : 0) + (has(self.persistentVolumeClaim) ? 1 : 0) == 1",message="exactly one of configMap, secret, emptyDir or persistentVolumeClaim must be set"
+type Volume struct {
+	// Name of the volume, referenced by volume mounts.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// Name of the `ConfigMap` to mount.
+	ConfigMap string `json:"configMap,omitempty"`
+	// Name of the `Secret` to mount.
+	Secret string `json:"secret,omitempty"`
+	// An empty directory sharing the lifetime of the pod.
+	EmptyDir *EmptyDirVolume `json:"emptyDir,omitempty"`
+	// Name of the `PersistentVolumeClaim` to mount.
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+}
+
+type EmptyDirVolume struct {
+	// Set to `Memory` to back the directory by memory instead of the node's disk.
+	// +kubebuilder:validation:Enum=Memory
+	Medium string `json:"medium,omitempty"`
+	// The maximum size of the directory.
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+}
+
+type VolumeMount struct {
+	// Name of the volume to mount.
+	Name string `json:"name"`
+	// Path within the container to mount the volume at.
+	// +kubebuilder:validation:Pattern=`^/`
+	MountPath string `json:"mountPath"`
+	// Path within the volume to mount. Defaults to the root of the volume.
+	SubPath string `json:"subPath,omitempty"`
+	// Mount the volume read-only.
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'Recreate' || (!has(self.maxSurge) && !has(self.maxUnavailable))",message="maxSurge and maxUnavailable can only be set for the RollingUpdate strategy"
+type Strategy struct {
+	// Type of the strategy. `RollingUpdate` gradually replaces old pods with new ones,
+	// while `Recreate` stops all old pods before starting new ones.
+	// Defaults to `RollingUpdate`.
+	// +kubebuilder:validation:Enum=RollingUpdate;Recreate
+	Type string `json:"type,omitempty"`
+	// The maximum number of pods that can be started above the desired number of pods during a rolling update.
+	// Either a number or a percentage, such as `25%`.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// The maximum number of pods that can be unavailable during a rolling update.
+	// Either a number or a percentage, such as `25%`.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must be less than or equal to maxReplicas"
+type Autoscaling struct {
+	// The minimum number of replicas. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// The maximum number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// Target average CPU utilization, in percent of the requested CPU.
+	// Defaults to 80 when neither `cpu` nor `memory` is set.
+	// +kubebuilder:validation:Minimum=1
+	CPU *int32 `json:"cpu,omitempty"`
+	// Target average memory utilization, in percent of the requested memory.
+	// +kubebuilder:validation:Minimum=1
+	Memory *int32 `json:"memory,omitempty"`
+}
+
+type Port struct {
+	// Name of the port, used for both the container and the Service port.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// The port number exposed by the container. The Service exposes the same port number.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// The protocol of the port. Defaults to `TCP`.
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+	Protocol string `json:"protocol,omitempty"`
+	// The application protocol of the port, such as `http`, `h2c` or `kubernetes.io/ws`.
+	AppProtocol string `json:"appProtocol,omitempty"`
+}
+
+type Service struct {
+	// The type of the Service. Defaults to `ClusterIP`.
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	Type string `json:"type,omitempty"`
+	// Annotations added to the Service, such as load balancer configuration.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Probe checks the health of the application.
+// Exactly one of `path`, `tcp`, `grpc` or `exec` must be set.
+// +kubebuilder:validation:XValidation:rule="(has(self.path) ? 1 : 0) + (has(self.tcp) && self.tcp ? 1 : 0) + (has(self.grpc) ? 1 : 0) + (has(self.exec) ? 1 : 0) == 1",message="exactly one of path, tcp, grpc or exec must be set"
+type Probe struct {
+	// Path of an HTTP GET request. The probe succeeds when the response has a status code between 200 and 399.
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path,omitempty"`
+	// Check that a TCP connection can be opened.
+	TCP bool `json:"tcp,omitempty"`
+	// Check the application using the [gRPC health checking protocol](https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+	GRPC *GRPCProbe `json:"grpc,omitempty"`
+	// Command executed in the container. The probe succeeds when the command exits with status code 0.
+	Exec []string `json:"exec,omitempty"`
+
+	// The port used by `path`, `tcp` and `grpc` probes. Defaults to `port` of the application.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int `json:"port,omitempty"`
+
+	// Number of seconds after the container has started before the probe is initiated.
+	// +kubebuilder:validation:Minimum=0
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+	// How often, in seconds, to perform the probe. Defaults to 10 seconds.
+	// +kubebuilder:validation:Minimum=1
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+	// Number of seconds after which the probe times out. Defaults to 1 second.
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+	// Minimum consecutive successes for the probe to be considered successful after having failed.
+	// Defaults to 1, and must be 1 for liveness and startup probes.
+	// +kubebuilder:validation:Minimum=1
+	SuccessThreshold int32 `json:"successThreshold,omitempty"`
+	// Minimum consecutive failures for the probe to be considered failed after having succeeded.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+type GRPCProbe struct {
+	// Name of the service to check. When empty, the overall health of the server is checked.
+	Service string `json:"service,omitempty"`
+}
+
+type Probes struct {
+	// Liveness probes restart the container when they fail.
+	Liveness *Probe `json:"liveness,omitempty"`
+	// Readiness probes stop traffic to the container while they fail.
+	Readiness *Probe `json:"readiness,omitempty"`
+	// Startup probes delay the other probes until the container has started.
+	// The container is restarted if the startup probe doesn't succeed in time.
+	Startup *Probe `json:"startup,omitempty"`
+}
+
 // ApplicationSpec defines the desired state of Application
+// +kubebuilder:validation:XValidation:rule="!has(self.port) || self.port == 0 || !has(self.ports) || self.ports.all(p, p.name != 'http')",message="the port name http is reserved when port is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.port) || self.port == 0 || !has(self.ports) || self.ports.all(p, p.port != 80)",message="port 80 is reserved when port is set"
 type ApplicationSpec struct {
 	// The port number which is exposed by the container and should receive traffic.
+	// It's exposed as a port named `http`, on port 80 of the Service.
 	Port int `json:"port,omitempty"`
 
+	// Named ports exposed by the container and the Service, in addition to `port`.
+	// When `port` is set, the name `http` and port 80 are reserved for it.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Ports []Port `json:"ports,omitempty"`
+
+	// Service configures the Service created when the application exposes any ports.
+	// +optional
+	Service *Service `json:"service,omitempty"`
+
 	// Override command when starting Docker image.
 	Command []string `json:"command,omitempty"`
 
@@ -91,9 +341,40 @@ type ApplicationSpec struct {
 	// The ConfigMap and Secret resources must live in the same Kubernetes namespace as the Application resource.
 	EnvFrom []EnvFrom `json:"envFrom,omitempty"`
 
+	// Volumes of the application pods, mounted in the container using `volumeMounts`.
+	// Volumes added by extensions must not share a name with these, unless they're identical.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Volumes []Volume `json:"volumes,omitempty"`
+
+	// Volumes mounted in the application container.
+	// +listType=map
+	// +listMapKey=mountPath
+	// +optional
+	VolumeMounts []VolumeMount `json:"volumeMounts,omitempty"`
+
 	//+optional
 	Resources *ResourceRequirements `json:"resources,omitempty"`
 
+	// Probes checking the health of the application.
+	// +optional
+	Probes *Probes `json:"probes,omitempty"`
+
+	// The number of replicas of the application. Defaults to 1.
+	// Ignored when `autoscaling` is set, as the number of replicas is managed by the autoscaler.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// The strategy used to replace old pods with new ones.
+	// +optional
+	Strategy *Strategy `json:"strategy,omitempty"`
+
+	// Autoscaling scales the number of replicas based on the resource usage of the application.
+	// +optional
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+
 	Rest unstructured.Unstructured `json:"-"`
 }
 
@@ -106,11 +387,21 @@ type ApplicationStatus struct {
 	Hash string `json:"hash,omitempty"`
 	// +optional
 	Extensions []string `json:"extensions,omitempty"`
+	// ExtensionStatuses contains the status reported by each extension, keyed by the name of the extension.
+	// +optional
+	ExtensionStatuses map[string]OwnerExtensionStatus `json:"extensionStatuses,omitempty"`
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:shortName=app
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 //+kubebuilder:object:root=true
 // +genclient
 
@@ -149,6 +440,14 @@ func (a *Application) GetSpec() []byte {
 	return b
 }
 
+func (a *Application) GetConditions() []metav1.Condition {
+	return a.Status.Conditions
+}
+
+func (a *Application) SetConditions(conditions []metav1.Condition) {
+	a.Status.Conditions = conditions
+}
+
 func (a *Application) WellKnownSpec() (ApplicationSpec, error) {
 	if a == nil {
 		return ApplicationSpec{}, nil
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 func TestApplication_GetSpec(t *testing.T) {
@@ -234,3 +236,74 @@ func TestApplicationSpec_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceRequirements_Validate(t *testing.T) {
+	q := func(s string) *resource.Quantity {
+		v := resource.MustParse(s)
+		return &v
+	}
+	valid := func() *ResourceRequirements {
+		return &ResourceRequirements{
+			Limits:   ResourceRequirementsLimits{CPU: q("1"), Memory: resource.MustParse("256Mi"), EphemeralStorage: q("1Gi")},
+			Requests: ResourceRequirementsRequests{CPU: resource.MustParse("100m"), Memory: resource.MustParse("128Mi"), EphemeralStorage: q("512Mi")},
+		}
+	}
+
+	tests := map[string]struct {
+		modify func(r *ResourceRequirements)
+		want   []string
+	}{
+		"valid": {},
+		"cpu request above limit": {
+			modify: func(r *ResourceRequirements) { r.Requests.CPU = resource.MustParse("2") },
+			want:   []string{"spec.resources.requests.cpu"},
+		},
+		"memory request above limit": {
+			modify: func(r *ResourceRequirements) { r.Requests.Memory = resource.MustParse("1Gi") },
+			want:   []string{"spec.resources.requests.memory"},
+		},
+		"ephemeral storage request above limit": {
+			modify: func(r *ResourceRequirements) { r.Requests.EphemeralStorage = q("2Gi") },
+			want:   []string{"spec.resources.requests.ephemeralStorage"},
+		},
+		"cpu request without limit": {
+			modify: func(r *ResourceRequirements) { r.Limits.CPU = nil; r.Requests.CPU = resource.MustParse("8") },
+		},
+		"extended resources": {
+			modify: func(r *ResourceRequirements) {
+				r.Limits.Extended = map[string]resource.Quantity{
+					"nvidia.com/gpu":         resource.MustParse("1"),
+					"gpu":                    resource.MustParse("1"),
+					"kubernetes.io/foo":      resource.MustParse("1"),
+					"node.kubernetes.io/bar": resource.MustParse("1"),
+					"example.com/half":       resource.MustParse("500m"),
+					"example.com/In valid":   resource.MustParse("1"),
+				}
+			},
+			want: []string{
+				"spec.resources.limits.extended[example.com/In valid]",
+				"spec.resources.limits.extended[example.com/half]",
+				"spec.resources.limits.extended[gpu]",
+				"spec.resources.limits.extended[kubernetes.io/foo]",
+				"spec.resources.limits.extended[node.kubernetes.io/bar]",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := valid()
+			if tc.modify != nil {
+				tc.modify(r)
+			}
+
+			var got []string
+			for _, err := range r.Validate(field.NewPath("spec", "resources")) {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
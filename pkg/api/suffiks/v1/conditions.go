@@ -0,0 +1,52 @@
+package v1
+
+// Conditions reported by Applications and Works.
+const (
+	// ConditionReady is true when the object is synced, its extensions are
+	// healthy and its workload is ready.
+	ConditionReady = "Ready"
+	// ConditionSynced is true when all extensions synced the object successfully
+	// and the resulting resources were applied.
+	ConditionSynced = "Synced"
+	// ConditionExtensionsHealthy is true when all extensions used by the object are able to serve requests.
+	ConditionExtensionsHealthy = "ExtensionsHealthy"
+	// ConditionProgressing is true while the workload of the object is rolling out.
+	ConditionProgressing = "Progressing"
+
+	ReasonReady                   = "Ready"
+	ReasonNotSynced               = "NotSynced"
+	ReasonWorkloadNotReady        = "WorkloadNotReady"
+	ReasonSynced                  = "Synced"
+	ReasonExtensionFailed         = "ExtensionFailed"
+	ReasonExtensionPanic          = "ExtensionPanic"
+	ReasonApplyConflict           = "ApplyConflict"
+	ReasonApplyFailed             = "ApplyFailed"
+	ReasonHealthy                 = "Healthy"
+	ReasonExtensionUnavailable    = "ExtensionUnavailable"
+	ReasonProgressing             = "Progressing"
+	ReasonComplete                = "Complete"
+	ReasonExtensionFailureIgnored = "ExtensionFailureIgnored"
+	ReasonApplySkipped            = "ApplySkipped"
+)
+
+// Conditions reported by Extensions.
+const (
+	// ExtensionConditionReady is true when the extension is loaded, its schema
+	// is merged and it is reachable.
+	ExtensionConditionReady = "Ready"
+	// ExtensionConditionLoaded is true when the WASI module is loaded or the gRPC connection is set up.
+	ExtensionConditionLoaded = "Loaded"
+	// ExtensionConditionSchemaMerged is true when the extension schema is merged into the CRDs of its targets.
+	ExtensionConditionSchemaMerged = "SchemaMerged"
+	// ExtensionConditionReachable is true when the extension responds to health checks.
+	ExtensionConditionReachable = "Reachable"
+
+	ExtensionReasonReady           = "Ready"
+	ExtensionReasonLoaded          = "Loaded"
+	ExtensionReasonLoadFailed      = "LoadFailed"
+	ExtensionReasonSchemaMerged    = "SchemaMerged"
+	ExtensionReasonSchemaConflict  = "SchemaConflict"
+	ExtensionReasonCRDUpdateFailed = "CRDUpdateFailed"
+	ExtensionReasonReachable       = "Reachable"
+	ExtensionReasonUnavailable     = "Unavailable"
+)
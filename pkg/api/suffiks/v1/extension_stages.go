@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ExtensionStages groups extensions, keyed by name, into stages.
+//
+// An extension is placed in a later stage than the extensions it depends on,
+// and the extensions with a higher priority. Dependencies not in specs are
+// ignored. Names within a stage are sorted. An error is returned if the
+// dependencies and priorities contain a cycle.
+func ExtensionStages(specs map[string]ExtensionSpec) ([][]string, error) {
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	after := map[string][]string{}
+	waitingFor := map[string]int{}
+	for _, name := range names {
+		spec := specs[name]
+		deps := map[string]struct{}{}
+		for _, dep := range spec.DependsOn {
+			if _, ok := specs[dep]; ok {
+				deps[dep] = struct{}{}
+			}
+		}
+		for _, other := range names {
+			if specs[other].Priority > spec.Priority {
+				deps[other] = struct{}{}
+			}
+		}
+
+		for dep := range deps {
+			after[dep] = append(after[dep], name)
+		}
+		waitingFor[name] = len(deps)
+	}
+
+	var stages [][]string
+	var current []string
+	for _, name := range names {
+		if waitingFor[name] == 0 {
+			current = append(current, name)
+		}
+	}
+
+	done := 0
+	for len(current) > 0 {
+		stages = append(stages, current)
+		done += len(current)
+
+		var next []string
+		for _, name := range current {
+			for _, dependent := range after[name] {
+				waitingFor[dependent]--
+				if waitingFor[dependent] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+		sort.Strings(next)
+		current = next
+	}
+
+	if done < len(names) {
+		var cycle []string
+		for _, name := range names {
+			if waitingFor[name] > 0 {
+				cycle = append(cycle, name)
+			}
+		}
+		return nil, fmt.Errorf("dependency cycle involving extensions: %s", strings.Join(cycle, ", "))
+	}
+	return stages, nil
+}
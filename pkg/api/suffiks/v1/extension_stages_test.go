@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtensionStages(t *testing.T) {
+	tests := map[string]struct {
+		specs   map[string]ExtensionSpec
+		want    [][]string
+		wantErr bool
+	}{
+		"empty": {},
+		"parallel": {
+			specs: map[string]ExtensionSpec{"b": {}, "a": {}, "c": {}},
+			want:  [][]string{{"a", "b", "c"}},
+		},
+		"priority": {
+			specs: map[string]ExtensionSpec{"a": {}, "b": {Priority: 10}, "c": {Priority: 10}, "d": {Priority: -1}},
+			want:  [][]string{{"b", "c"}, {"a"}, {"d"}},
+		},
+		"dependencies": {
+			specs: map[string]ExtensionSpec{
+				"ingress": {DependsOn: []string{"cert"}},
+				"cert":    {},
+				"dns":     {},
+				"monitor": {DependsOn: []string{"ingress", "dns"}},
+			},
+			want: [][]string{{"cert", "dns"}, {"ingress"}, {"monitor"}},
+		},
+		"unknown dependencies are ignored": {
+			specs: map[string]ExtensionSpec{"a": {DependsOn: []string{"missing"}}},
+			want:  [][]string{{"a"}},
+		},
+		"dependency cycle": {
+			specs: map[string]ExtensionSpec{
+				"a": {DependsOn: []string{"b"}},
+				"b": {DependsOn: []string{"a"}},
+				"c": {},
+			},
+			wantErr: true,
+		},
+		"priority conflicting with dependency": {
+			specs: map[string]ExtensionSpec{
+				"a": {Priority: 1, DependsOn: []string{"b"}},
+				"b": {},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ExtensionStages(tc.specs)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ExtensionStages() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
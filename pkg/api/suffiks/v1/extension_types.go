@@ -20,9 +20,18 @@ func (e ExtensionGRPCController) Target() string {
 	return net.JoinHostPort(e.Service+"."+e.Namespace, strconv.Itoa(e.Port))
 }
 
-// +kubebuilder:validation:Enum=get;create;update;delete
+// +kubebuilder:validation:Enum=get;list;create;update;patch;apply;delete
 type Method string
 
+// ResourceScope is the scope of a Kubernetes resource.
+// +kubebuilder:validation:Enum=Namespaced;Cluster
+type ResourceScope string
+
+const (
+	ResourceScopeNamespaced ResourceScope = "Namespaced"
+	ResourceScopeCluster    ResourceScope = "Cluster"
+)
+
 type ExtensionWASIControllerResource struct {
 	//+kubebuilder:validation:Pattern=^[a-z]([-a-z0-9\.]*[a-z0-9])?$
 	Group string `json:"group"`
@@ -32,6 +41,17 @@ type ExtensionWASIControllerResource struct {
 	Resource string `json:"resource"`
 	// +required
 	Methods []Method `json:"methods"`
+
+	// Scope of the resource. Cluster scoped resources are accessed without a namespace.
+	// +kubebuilder:default=Namespaced
+	// +optional
+	Scope ResourceScope `json:"scope,omitempty"`
+
+	// Namespaces the extension may access the resource in, in addition to the
+	// namespace of the object the extension runs for.
+	// Must be empty for cluster scoped resources.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 func (e ExtensionWASIControllerResource) String() string {
@@ -45,6 +65,22 @@ type ConfigMapReference struct {
 	Namespace string `json:"namespace"`
 }
 
+// ExtensionWASILimits restricts the resources used by each call to a WASI extension.
+type ExtensionWASILimits struct {
+	// MaxMemoryPages is the maximum number of 64KiB memory pages available to the extension.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65536
+	// +optional
+	MaxMemoryPages uint32 `json:"maxMemoryPages,omitempty"`
+	// Timeout is the maximum duration of a single call to the extension.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// CloseOnContextDone stops a running call when it times out or is cancelled.
+	// Defaults to true.
+	// +optional
+	CloseOnContextDone *bool `json:"closeOnContextDone,omitempty"`
+}
+
 type ExtensionWASIController struct {
 	Image string `json:"image"`
 	Tag   string `json:"tag"`
@@ -52,6 +88,8 @@ type ExtensionWASIController struct {
 	Resources []ExtensionWASIControllerResource `json:"resources,omitempty"`
 	// +optional
 	ConfigMap *ConfigMapReference `json:"configMap,omitempty"`
+	// +optional
+	Limits *ExtensionWASILimits `json:"limits,omitempty"`
 }
 
 func (e *ExtensionWASIController) ImageTag() string {
@@ -66,6 +104,30 @@ type ExtensionWebhooks struct {
 	Defaulting bool `json:"defaulting,omitempty"`
 }
 
+// FailurePolicy defines how failures of an extension are handled.
+// +kubebuilder:validation:Enum=Fail;Ignore
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail fails the operation when the extension fails.
+	FailurePolicyFail FailurePolicy = "Fail"
+	// FailurePolicyIgnore ignores failures of the extension, reporting them as warnings.
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+)
+
+// ExtensionTimeouts limits the duration of each operation of an extension.
+// Operations without a timeout are only limited by the request they're part of.
+type ExtensionTimeouts struct {
+	// +optional
+	Sync *metav1.Duration `json:"sync,omitempty"`
+	// +optional
+	Validate *metav1.Duration `json:"validate,omitempty"`
+	// +optional
+	Default *metav1.Duration `json:"default,omitempty"`
+	// +optional
+	Delete *metav1.Duration `json:"delete,omitempty"`
+}
+
 type ControllerSpec struct {
 	// +optional
 	GRPC *ExtensionGRPCController `json:"grpc,omitempty"`
@@ -83,6 +145,53 @@ type ExtensionSpec struct {
 
 	// Always call the extension, even if the extension schema isn't set
 	Always bool `json:"always,omitempty"`
+
+	// Priority orders the extensions running for the same object.
+	// Extensions with a higher priority run, and finish, before extensions with a lower priority.
+	// Extensions with the same priority run in parallel.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// DependsOn lists extensions which must run, and finish, before this extension.
+	// Their outputs are passed to this extension in the sync request.
+	// Dependencies not running for an object are ignored.
+	// +listType=set
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// NamespaceSelector limits the extension to objects in namespaces matching the selector.
+	// The extension applies to objects in all namespaces when not set.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// ObjectSelector limits the extension to objects with labels matching the selector.
+	// The extension applies to all objects when not set.
+	// +optional
+	ObjectSelector *metav1.LabelSelector `json:"objectSelector,omitempty"`
+
+	// FailurePolicy defines how failures of the extension are handled.
+	// With Ignore, failures don't block admission or the sync of objects,
+	// and are reported as warnings and conditions instead.
+	// +kubebuilder:default=Fail
+	// +optional
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// Timeout limits the duration of each operation of the extension.
+	// +optional
+	Timeout *ExtensionTimeouts `json:"timeout,omitempty"`
+}
+
+// OwnerExtensionStatus is the status an extension reports for an Application or Work it runs for.
+type OwnerExtensionStatus struct {
+	// Phase is a short, machine readable, state of the extension for the object.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// Message is a human readable description of the state.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Outputs are values published by the extension, such as the URL of an ingress.
+	// +optional
+	Outputs map[string]string `json:"outputs,omitempty"`
 }
 
 type ExtensionStatusText string
@@ -95,15 +204,25 @@ const (
 type ExtensionStatus struct {
 	// +optional
 	Status ExtensionStatusText `json:"status,omitempty"`
+	// Version is the image and tag of the loaded WASI module.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:scope=Cluster,shortName=ext
 //+kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
 //+kubebuilder:printcolumn:name="Always",type=boolean,JSONPath=`.spec.always`
 //+kubebuilder:printcolumn:name="Validation",type=boolean,JSONPath=`.spec.webhooks.validation`
 //+kubebuilder:printcolumn:name="Defaulting",type=boolean,JSONPath=`.spec.webhooks.defaulting`
+//+kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`,priority=1
 // +genclient:nonNamespaced
 
 // Extension is the Schema for the extensions API
@@ -3,12 +3,17 @@ package v1
 import (
 	"context"
 	"encoding/json"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/suffiks/suffiks/internal/extension/oci"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -20,14 +25,49 @@ import (
 var extensionlog = logf.Log.WithName("extension-resource")
 
 func (r *Extension) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	reader := mgr.GetAPIReader()
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithValidator(&extensionValidator{
+			lister: func(ctx context.Context) ([]Extension, error) {
+				list := &ExtensionList{}
+				if err := reader.List(ctx, list); err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			},
+		}).
 		Complete()
 }
 
+// extensionValidator validates extensions, including their dependencies on
+// the other extensions in the cluster.
+type extensionValidator struct {
+	lister extensionLister
+}
+
+func (v *extensionValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ext := obj.(*Extension)
+	extensionlog.Info("validate create", "name", ext.Name)
+	return nil, ext.validateExtension(withExtensionLister(ctx, v.lister))
+}
+
+func (v *extensionValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	ext := newObj.(*Extension)
+	extensionlog.Info("validate update", "name", ext.Name)
+	return nil, ext.validateExtension(withExtensionLister(ctx, v.lister))
+}
+
+func (v *extensionValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
 //+kubebuilder:webhook:path=/validate-suffiks-com-v1-extension,mutating=false,failurePolicy=fail,sideEffects=None,groups=suffiks.com,resources=extensions,verbs=create;update,versions=v1,name=vextension.kb.io,admissionReviewVersions=v1
 
-var _ webhook.Validator = &Extension{}
+var (
+	_ webhook.Validator         = &Extension{}
+	_ admission.CustomValidator = &extensionValidator{}
+)
 
 func (r *Extension) validateExtension(opts ...valOpts) error {
 	validateOpts := &validateOpts{
@@ -51,6 +91,10 @@ func (r *Extension) validateSpec(opts *validateOpts) (allErrs field.ErrorList) {
 		r.validateSpecTarget,
 		r.validateSpecOpenAPIV3Schema,
 		r.validateWASIImage,
+		r.validateDependencies,
+		r.validateSelectors,
+		r.validateTimeout,
+		r.validateWASIResources,
 	}
 
 	for _, v := range validations {
@@ -107,6 +151,102 @@ func (r *Extension) validateWASIImage(opts *validateOpts) *field.Error {
 	return nil
 }
 
+// validateDependencies checks that the extension doesn't depend on itself, and
+// that its dependencies and priority don't form a cycle with other extensions
+// targeting the same kinds.
+func (r *Extension) validateDependencies(opts *validateOpts) *field.Error {
+	path := field.NewPath("spec", "dependsOn")
+	for _, dep := range r.Spec.DependsOn {
+		if dep == r.Name {
+			return field.Invalid(path, r.Spec.DependsOn, "Must not depend on itself")
+		}
+	}
+
+	if opts.lister == nil {
+		return nil
+	}
+
+	exts, err := opts.lister(opts.ctx)
+	if err != nil {
+		return field.InternalError(path, err)
+	}
+
+	for _, target := range r.Spec.Targets {
+		specs := map[string]ExtensionSpec{r.Name: r.Spec}
+		for _, ext := range exts {
+			if ext.Name != r.Name && slices.Contains(ext.Spec.Targets, target) {
+				specs[ext.Name] = ext.Spec
+			}
+		}
+
+		if _, err := ExtensionStages(specs); err != nil {
+			return field.Invalid(path, r.Spec.DependsOn, err.Error())
+		}
+	}
+	return nil
+}
+
+// validateSelectors checks that the namespace and object selectors are valid label selectors.
+func (r *Extension) validateSelectors(opts *validateOpts) *field.Error {
+	path := field.NewPath("spec")
+	opt := metav1validation.LabelSelectorValidationOptions{}
+	if errs := metav1validation.ValidateLabelSelector(r.Spec.NamespaceSelector, opt, path.Child("namespaceSelector")); len(errs) > 0 {
+		return errs[0]
+	}
+	if errs := metav1validation.ValidateLabelSelector(r.Spec.ObjectSelector, opt, path.Child("objectSelector")); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// validateTimeout checks that the operation timeouts are positive.
+func (r *Extension) validateTimeout(opts *validateOpts) *field.Error {
+	if r.Spec.Timeout == nil {
+		return nil
+	}
+
+	path := field.NewPath("spec", "timeout")
+	timeouts := []struct {
+		name     string
+		duration *metav1.Duration
+	}{
+		{"sync", r.Spec.Timeout.Sync},
+		{"validate", r.Spec.Timeout.Validate},
+		{"default", r.Spec.Timeout.Default},
+		{"delete", r.Spec.Timeout.Delete},
+	}
+	for _, t := range timeouts {
+		if t.duration != nil && t.duration.Duration <= 0 {
+			return field.Invalid(path.Child(t.name), t.duration.Duration.String(), "Must be positive")
+		}
+	}
+	return nil
+}
+
+// validateWASIResources checks that the namespaces of the WASI resources are
+// valid, and only set for namespaced resources.
+func (r *Extension) validateWASIResources(opts *validateOpts) *field.Error {
+	if r.Spec.Controller.WASI == nil {
+		return nil
+	}
+
+	path := field.NewPath("spec", "controller", "wasi", "resources")
+	for i, res := range r.Spec.Controller.WASI.Resources {
+		if len(res.Namespaces) == 0 {
+			continue
+		}
+		if res.Scope == ResourceScopeCluster {
+			return field.Forbidden(path.Index(i).Child("namespaces"), "Must be empty for cluster scoped resources")
+		}
+		for j, ns := range res.Namespaces {
+			if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+				return field.Invalid(path.Index(i).Child("namespaces").Index(j), ns, strings.Join(errs, ", "))
+			}
+		}
+	}
+	return nil
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *Extension) ValidateCreate() (admission.Warnings, error) {
 	extensionlog.Info("validate create", "name", r.Name)
@@ -126,9 +266,12 @@ func (r *Extension) ValidateDelete() (admission.Warnings, error) {
 }
 
 type (
-	ociGetter    func(ctx context.Context, image string, tag string) (map[string][]byte, error)
-	validateOpts struct {
+	ociGetter       func(ctx context.Context, image string, tag string) (map[string][]byte, error)
+	extensionLister func(ctx context.Context) ([]Extension, error)
+	validateOpts    struct {
 		ociGetter ociGetter
+		lister    extensionLister
+		ctx       context.Context
 	}
 	valOpts func(*validateOpts)
 )
@@ -138,3 +281,10 @@ func withOciGetter(getter ociGetter) func(*validateOpts) {
 		opts.ociGetter = getter
 	}
 }
+
+func withExtensionLister(ctx context.Context, lister extensionLister) func(*validateOpts) {
+	return func(opts *validateOpts) {
+		opts.ctx = ctx
+		opts.lister = lister
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -197,3 +198,174 @@ func TestExtension_ValidateCreate(t *testing.T) {
 		t.Errorf("Extension.ValidateDelete() error = %v", err)
 	}
 }
+
+func TestExtension_validateDependencies(t *testing.T) {
+	ext := func(name string, targets []Target, dependsOn ...string) Extension {
+		return Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       ExtensionSpec{Targets: targets, DependsOn: dependsOn},
+		}
+	}
+	app := []Target{"Application"}
+	work := []Target{"Work"}
+
+	existing := []Extension{
+		ext("cert", app),
+		ext("ingress", app, "cert"),
+		ext("cron", work, "backup"),
+	}
+	lister := func(context.Context) ([]Extension, error) { return existing, nil }
+
+	tests := map[string]struct {
+		ext     Extension
+		wantErr bool
+	}{
+		"no dependencies": {
+			ext: ext("dns", app),
+		},
+		"depends on existing extension": {
+			ext: ext("monitor", app, "ingress"),
+		},
+		"depends on itself": {
+			ext:     ext("monitor", app, "monitor"),
+			wantErr: true,
+		},
+		"cycle": {
+			ext:     ext("cert", app, "ingress"),
+			wantErr: true,
+		},
+		"cycle with extension of other target": {
+			ext: ext("backup", app, "cron"),
+		},
+		"cycle through priority": {
+			ext: Extension{
+				ObjectMeta: metav1.ObjectMeta{Name: "dns"},
+				Spec:       ExtensionSpec{Targets: app, Priority: 10, DependsOn: []string{"ingress"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := &validateOpts{}
+			withExtensionLister(context.Background(), lister)(opts)
+
+			if err := tc.ext.validateDependencies(opts); (err != nil) != tc.wantErr {
+				t.Errorf("validateDependencies() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtension_validateSelectors(t *testing.T) {
+	tests := map[string]struct {
+		spec    ExtensionSpec
+		wantErr bool
+	}{
+		"no selectors": {},
+		"valid selectors": {
+			spec: ExtensionSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"mesh": "enabled"}},
+				ObjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"a", "b"}},
+				}},
+			},
+		},
+		"invalid namespace selector": {
+			spec: ExtensionSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"not valid": "x"}},
+			},
+			wantErr: true,
+		},
+		"invalid object selector": {
+			spec: ExtensionSpec{
+				ObjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: metav1.LabelSelectorOpIn},
+				}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ext := &Extension{Spec: tc.spec}
+			if err := ext.validateSelectors(&validateOpts{}); (err != nil) != tc.wantErr {
+				t.Errorf("validateSelectors() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtension_validateTimeout(t *testing.T) {
+	tests := map[string]struct {
+		timeout *ExtensionTimeouts
+		wantErr bool
+	}{
+		"no timeout": {},
+		"positive timeouts": {
+			timeout: &ExtensionTimeouts{
+				Sync:     &metav1.Duration{Duration: 30 * time.Second},
+				Validate: &metav1.Duration{Duration: time.Second},
+			},
+		},
+		"zero timeout": {
+			timeout: &ExtensionTimeouts{Default: &metav1.Duration{}},
+			wantErr: true,
+		},
+		"negative timeout": {
+			timeout: &ExtensionTimeouts{Delete: &metav1.Duration{Duration: -time.Second}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ext := &Extension{Spec: ExtensionSpec{Timeout: tc.timeout}}
+			if err := ext.validateTimeout(&validateOpts{}); (err != nil) != tc.wantErr {
+				t.Errorf("validateTimeout() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtension_validateWASIResources(t *testing.T) {
+	tests := map[string]struct {
+		resources []ExtensionWASIControllerResource
+		wantErr   bool
+	}{
+		"no resources": {},
+		"namespaced with namespaces": {
+			resources: []ExtensionWASIControllerResource{
+				{Version: "v1", Resource: "configmaps", Scope: ResourceScopeNamespaced, Namespaces: []string{"platform"}},
+			},
+		},
+		"cluster scoped": {
+			resources: []ExtensionWASIControllerResource{
+				{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses", Scope: ResourceScopeCluster},
+			},
+		},
+		"cluster scoped with namespaces": {
+			resources: []ExtensionWASIControllerResource{
+				{Version: "v1", Resource: "namespaces", Scope: ResourceScopeCluster, Namespaces: []string{"platform"}},
+			},
+			wantErr: true,
+		},
+		"invalid namespace": {
+			resources: []ExtensionWASIControllerResource{
+				{Version: "v1", Resource: "configmaps", Namespaces: []string{"Platform"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ext := &Extension{Spec: ExtensionSpec{Controller: ControllerSpec{WASI: &ExtensionWASIController{Resources: tc.resources}}}}
+			if err := ext.validateWASIResources(&validateOpts{}); (err != nil) != tc.wantErr {
+				t.Errorf("validateWASIResources() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
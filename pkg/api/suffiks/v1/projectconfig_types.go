@@ -0,0 +1,190 @@
+package v1
+
+import (
+	"net"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ProjectConfigKind is the kind of the operator configuration file.
+const ProjectConfigKind = "ProjectConfig"
+
+// ProjectConfig is the configuration file of the operator.
+type ProjectConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Health configures the health probe endpoints.
+	Health ProjectConfigHealth `json:"health,omitempty"`
+	// Metrics configures the metrics endpoint.
+	Metrics ProjectConfigMetrics `json:"metrics,omitempty"`
+	// Webhook configures the webhook server.
+	Webhook ProjectConfigWebhook `json:"webhook,omitempty"`
+	// LeaderElection configures leader election between operator replicas.
+	LeaderElection ProjectConfigLeaderElection `json:"leaderElection,omitempty"`
+
+	// WebhooksDisabled disables the defaulting and validating webhooks.
+	// Extensions are then unable to default and validate objects.
+	WebhooksDisabled bool `json:"webhooksDisabled,omitempty"`
+	// DocumentationAddress is the address the documentation server binds to.
+	// Defaults to `:8084`.
+	DocumentationAddress string `json:"documentationAddress,omitempty"`
+
+	// ApplicationDefaults are applied to all Applications.
+	ApplicationDefaults *ApplicationDefaults `json:"applicationDefaults,omitempty"`
+	// Tracing configures OpenTelemetry tracing.
+	Tracing TracingConfig `json:"tracing,omitempty"`
+	// OCI configures how WASI extensions are fetched from OCI registries.
+	OCI OCIConfig `json:"oci,omitempty"`
+	// WASI configures the runtime of WASI extensions.
+	WASI WASIConfig `json:"wasi,omitempty"`
+}
+
+type ProjectConfigHealth struct {
+	// HealthProbeBindAddress is the address the health probe endpoints bind to.
+	HealthProbeBindAddress string `json:"healthProbeBindAddress,omitempty"`
+}
+
+type ProjectConfigMetrics struct {
+	// BindAddress is the address the metrics endpoint binds to.
+	BindAddress string `json:"bindAddress,omitempty"`
+}
+
+type ProjectConfigWebhook struct {
+	// Port is the port the webhook server listens on.
+	Port int `json:"port,omitempty"`
+}
+
+type ProjectConfigLeaderElection struct {
+	// LeaderElect enables leader election.
+	LeaderElect *bool `json:"leaderElect,omitempty"`
+	// ResourceName is the name of the lock used for leader election.
+	ResourceName string `json:"resourceName,omitempty"`
+	// ResourceNamespace is the namespace of the lock used for leader election.
+	ResourceNamespace string `json:"resourceNamespace,omitempty"`
+}
+
+type TracingConfig struct {
+	// OTLPEndpoint is the OTLP gRPC endpoint spans are exported to.
+	// Tracing is disabled when empty, unless the OTEL_EXPORTER_OTLP_ENDPOINT
+	// environment variable is set.
+	OTLPEndpoint string `json:"otlpEndpoint,omitempty"`
+	// Insecure disables TLS when connecting to the endpoint.
+	Insecure bool `json:"insecure,omitempty"`
+	// Attributes are added to all spans.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Enabled reports whether tracing is configured.
+func (t TracingConfig) Enabled() bool {
+	return t.OTLPEndpoint != ""
+}
+
+type OCIConfig struct {
+	// PlainHTTPRegistries are registries accessed over plain HTTP instead of HTTPS.
+	PlainHTTPRegistries []string `json:"plainHTTPRegistries,omitempty"`
+	// CredentialsFile is the path to a Docker config file with registry credentials.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// Timeout is the maximum duration of fetching an extension.
+	// Defaults to 10s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+type WASIConfig struct {
+	// DefaultLimits are used for limits not set by the extension.
+	DefaultLimits *ExtensionWASILimits `json:"defaultLimits,omitempty"`
+	// MaxMemoryPages caps the number of memory pages of every extension.
+	MaxMemoryPages uint32 `json:"maxMemoryPages,omitempty"`
+	// MaxTimeout caps the timeout of every extension.
+	MaxTimeout *metav1.Duration `json:"maxTimeout,omitempty"`
+	// MaxOutputBytes is the maximum number of bytes of stdout and stderr
+	// logged for each call to an extension. Output exceeding it is dropped.
+	// Defaults to 16384.
+	MaxOutputBytes int `json:"maxOutputBytes,omitempty"`
+}
+
+// Validate checks the configuration, returning all errors found.
+func (c *ProjectConfig) Validate() field.ErrorList {
+	var errs field.ErrorList
+
+	if c.APIVersion != GroupVersion.String() {
+		errs = append(errs, field.NotSupported(field.NewPath("apiVersion"), c.APIVersion, []string{GroupVersion.String()}))
+	}
+	if c.Kind != ProjectConfigKind {
+		errs = append(errs, field.NotSupported(field.NewPath("kind"), c.Kind, []string{ProjectConfigKind}))
+	}
+
+	errs = append(errs, validateAddress(field.NewPath("health", "healthProbeBindAddress"), c.Health.HealthProbeBindAddress)...)
+	errs = append(errs, validateAddress(field.NewPath("metrics", "bindAddress"), c.Metrics.BindAddress)...)
+	errs = append(errs, validateAddress(field.NewPath("documentationAddress"), c.DocumentationAddress)...)
+	if c.Webhook.Port < 0 || c.Webhook.Port > 65535 {
+		errs = append(errs, field.Invalid(field.NewPath("webhook", "port"), c.Webhook.Port, "must be between 1 and 65535"))
+	}
+
+	errs = append(errs, c.ApplicationDefaults.Validate(field.NewPath("applicationDefaults"))...)
+
+	if c.Tracing.OTLPEndpoint != "" {
+		if _, _, err := net.SplitHostPort(c.Tracing.OTLPEndpoint); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("tracing", "otlpEndpoint"), c.Tracing.OTLPEndpoint, "must be a host:port pair"))
+		}
+	}
+	for k := range c.Tracing.Attributes {
+		if k == "" {
+			errs = append(errs, field.Invalid(field.NewPath("tracing", "attributes"), k, "keys must not be empty"))
+		}
+	}
+
+	for i, registry := range c.OCI.PlainHTTPRegistries {
+		if registry == "" {
+			errs = append(errs, field.Required(field.NewPath("oci", "plainHTTPRegistries").Index(i), "must not be empty"))
+		}
+	}
+	errs = append(errs, validateDuration(field.NewPath("oci", "timeout"), c.OCI.Timeout)...)
+
+	wasi := field.NewPath("wasi")
+	errs = append(errs, validateDuration(wasi.Child("maxTimeout"), c.WASI.MaxTimeout)...)
+	if c.WASI.MaxMemoryPages > 65536 {
+		errs = append(errs, field.Invalid(wasi.Child("maxMemoryPages"), c.WASI.MaxMemoryPages, "must be at most 65536"))
+	}
+	if c.WASI.MaxOutputBytes < 0 {
+		errs = append(errs, field.Invalid(wasi.Child("maxOutputBytes"), c.WASI.MaxOutputBytes, "must not be negative"))
+	}
+	if l := c.WASI.DefaultLimits; l != nil {
+		p := wasi.Child("defaultLimits")
+		errs = append(errs, validateDuration(p.Child("timeout"), l.Timeout)...)
+		if l.MaxMemoryPages > 65536 {
+			errs = append(errs, field.Invalid(p.Child("maxMemoryPages"), l.MaxMemoryPages, "must be at most 65536"))
+		}
+		if c.WASI.MaxMemoryPages > 0 && l.MaxMemoryPages > c.WASI.MaxMemoryPages {
+			errs = append(errs, field.Invalid(p.Child("maxMemoryPages"), l.MaxMemoryPages, "must not exceed wasi.maxMemoryPages"))
+		}
+		if c.WASI.MaxTimeout != nil && l.Timeout != nil && l.Timeout.Duration > c.WASI.MaxTimeout.Duration {
+			errs = append(errs, field.Invalid(p.Child("timeout"), l.Timeout.Duration.String(), "must not exceed wasi.maxTimeout"))
+		}
+	}
+
+	return errs
+}
+
+func validateAddress(path *field.Path, addr string) field.ErrorList {
+	if addr == "" || addr == "0" {
+		return nil
+	}
+
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, addr, "must be a host:port pair, or 0 to disable")}
+	}
+	if p, err := strconv.Atoi(port); err != nil || p < 0 || p > 65535 {
+		return field.ErrorList{field.Invalid(path, addr, "must have a valid port")}
+	}
+	return nil
+}
+
+func validateDuration(path *field.Path, d *metav1.Duration) field.ErrorList {
+	if d != nil && d.Duration <= 0 {
+		return field.ErrorList{field.Invalid(path, d.Duration.String(), "must be positive")}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProjectConfig_Validate(t *testing.T) {
+	valid := func() *ProjectConfig {
+		return &ProjectConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: "suffiks.com/v1", Kind: "ProjectConfig"},
+			Health:   ProjectConfigHealth{HealthProbeBindAddress: ":8081"},
+			Metrics:  ProjectConfigMetrics{BindAddress: "0"},
+			Webhook:  ProjectConfigWebhook{Port: 9443},
+			Tracing:  TracingConfig{OTLPEndpoint: "collector:4317"},
+			WASI: WASIConfig{
+				DefaultLimits:  &ExtensionWASILimits{MaxMemoryPages: 16, Timeout: &metav1.Duration{Duration: time.Second}},
+				MaxMemoryPages: 32,
+				MaxTimeout:     &metav1.Duration{Duration: time.Minute},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		modify func(c *ProjectConfig)
+		want   []string
+	}{
+		"valid": {},
+		"wrong kind": {
+			modify: func(c *ProjectConfig) { c.APIVersion = "v1"; c.Kind = "ConfigMap" },
+			want:   []string{"apiVersion", "kind"},
+		},
+		"invalid addresses": {
+			modify: func(c *ProjectConfig) {
+				c.Health.HealthProbeBindAddress = "8081"
+				c.DocumentationAddress = ":http-alt"
+				c.Webhook.Port = 70000
+			},
+			want: []string{"health.healthProbeBindAddress", "documentationAddress", "webhook.port"},
+		},
+		"invalid application defaults": {
+			modify: func(c *ProjectConfig) {
+				c.ApplicationDefaults = &ApplicationDefaults{Labels: map[string]string{"team": "not valid"}}
+			},
+			want: []string{"applicationDefaults.labels"},
+		},
+		"invalid tracing endpoint": {
+			modify: func(c *ProjectConfig) { c.Tracing.OTLPEndpoint = "collector" },
+			want:   []string{"tracing.otlpEndpoint"},
+		},
+		"default limits above maximum": {
+			modify: func(c *ProjectConfig) {
+				c.WASI.DefaultLimits.MaxMemoryPages = 64
+				c.WASI.DefaultLimits.Timeout.Duration = time.Hour
+			},
+			want: []string{"wasi.defaultLimits.maxMemoryPages", "wasi.defaultLimits.timeout"},
+		},
+		"negative output limit": {
+			modify: func(c *ProjectConfig) { c.WASI.MaxOutputBytes = -1 },
+			want:   []string{"wasi.maxOutputBytes"},
+		},
+		"negative durations": {
+			modify: func(c *ProjectConfig) {
+				c.OCI.Timeout = &metav1.Duration{Duration: -time.Second}
+				c.WASI.MaxTimeout = nil
+				c.WASI.DefaultLimits.Timeout.Duration = 0
+			},
+			want: []string{"oci.timeout", "wasi.defaultLimits.timeout"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := valid()
+			if tc.modify != nil {
+				tc.modify(c)
+			}
+
+			var got []string
+			for _, err := range c.Validate() {
+				got = append(got, err.Field)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,8 +4,13 @@ package v1
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 
+	"github.com/mitchellh/hashstructure/v2"
+	"github.com/perimeterx/marshmallow"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // WorkSpec defines the desired state of Work
@@ -38,6 +43,8 @@ type WorkSpec struct {
 	// Read more about [Kubernetes handling pod and container failures](https://kubernetes.io/docs/concepts/workloads/controllers/job/#handling-pod-and-container-failures)
 	// +kubebuilder:validation:Enum=OnFailure;Never
 	RestartPolicy string `json:"restartPolicy,omitempty"`
+
+	Rest unstructured.Unstructured `json:"-"`
 }
 
 type WorkStatus struct {
@@ -45,10 +52,20 @@ type WorkStatus struct {
 	Hash string `json:"hash,omitempty"`
 	// +optional
 	Extensions []string `json:"extensions,omitempty"`
+	// ExtensionStatuses contains the status reported by each extension, keyed by the name of the extension.
+	// +optional
+	ExtensionStatuses map[string]OwnerExtensionStatus `json:"extensionStatuses,omitempty"`
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Work is the base Schema for the work API.
 // This struct contains the base spec without any extensions.
@@ -62,6 +79,15 @@ type Work struct {
 	Status WorkStatus `json:"status,omitempty"`
 }
 
+//+kubebuilder:object:root=true
+
+// WorkList contains a list of Work
+type WorkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Work `json:"items"`
+}
+
 func (w *Work) GetSpec() []byte {
 	if w == nil {
 		return nil
@@ -71,6 +97,79 @@ func (w *Work) GetSpec() []byte {
 	return b
 }
 
+func (w *Work) GetConditions() []metav1.Condition {
+	return w.Status.Conditions
+}
+
+func (w *Work) SetConditions(conditions []metav1.Condition) {
+	w.Status.Conditions = conditions
+}
+
+func (w *Work) WellKnownSpec() (WorkSpec, error) {
+	if w == nil {
+		return WorkSpec{}, nil
+	}
+
+	return w.Spec, nil
+}
+
+func (w *Work) Hash() (string, error) {
+	if w == nil {
+		return "", fmt.Errorf("unable to hash nil work")
+	}
+
+	v := struct {
+		Spec   WorkSpec
+		Labels map[string]string
+	}{
+		Spec:   w.Spec,
+		Labels: w.Labels,
+	}
+	h, err := hashstructure.Hash(v, hashstructure.FormatV2, &hashstructure.HashOptions{
+		IgnoreZeroValue: true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(h, 16), nil
+}
+
 func init() {
-	// SchemeBuilder.Register(&Work{}, &WorkList{})
+	SchemeBuilder.Register(&Work{}, &WorkList{})
+}
+
+type _work WorkSpec
+
+func (w *WorkSpec) UnmarshalJSON(b []byte) error {
+	var work _work
+	rest, err := marshmallow.Unmarshal(b, &work, marshmallow.WithExcludeKnownFieldsFromMap(true))
+	if err != nil {
+		return err
+	}
+
+	*w = WorkSpec(work)
+	if len(rest) > 0 {
+		w.Rest.Object = rest
+	}
+	return nil
+}
+
+func (w WorkSpec) MarshalJSON() ([]byte, error) {
+	b1, err := json.Marshal(_work(w))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(w.Rest.Object) == 0 {
+		return b1, nil
+	}
+
+	b2, err := json.Marshal(w.Rest.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	b := append(append(b1[:len(b1)-1], ','), b2[1:]...)
+	return b, nil
 }
@@ -1,9 +1,12 @@
 package v1
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestWork_GetSpec(t *testing.T) {
@@ -43,3 +46,90 @@ func TestWork_GetSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestWork_Hash(t *testing.T) {
+	tests := map[string]struct {
+		work *Work
+		want string
+		err  error
+	}{
+		"nil": {
+			work: nil,
+			want: "",
+			err:  fmt.Errorf("unable to hash nil work"),
+		},
+
+		"empty": {
+			work: &Work{},
+			want: "cbf29ce484222325",
+		},
+
+		"scheduled": {
+			work: &Work{
+				Spec: WorkSpec{
+					Image:    "foo",
+					Schedule: "* * * * *",
+				},
+			},
+			want: "1ffbdeccb09d11d3",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tt.work.Hash()
+			if err != nil && err.Error() != tt.err.Error() {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkSpec_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data []byte
+		want WorkSpec
+	}{
+		"simple": {
+			data: []byte(`{"schedule":"* * * * *","image":"foo"}`),
+			want: WorkSpec{
+				Image:    "foo",
+				Schedule: "* * * * *",
+			},
+		},
+
+		"custom": {
+			data: []byte(`{"image":"foo","foo":"bar"}`),
+			want: WorkSpec{
+				Image: "foo",
+				Rest: unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"foo": "bar",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got WorkSpec
+			if err := got.UnmarshalJSON(tt.data); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("-want +got\n%s", diff)
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(tt.data), string(b)); diff != "" {
+				t.Errorf("roundtrip -want +got\n%s", diff)
+			}
+		})
+	}
+}